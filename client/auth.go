@@ -13,7 +13,8 @@ type AuthSettings struct {
 	GuestUsername         string  `json:"guestUsername"`
 	WelcomeText           string  `json:"welcomeText"`
 	CollapseLoginForm     bool    `json:"collapseLoginForm"`
-	TwoFactorMode         string  `json:"twoFactorMode"`
+	TwoFactorMode         string  `json:"twoFactorMode,omitempty"`
+	TwoFactorGracePeriod  *int64  `json:"twoFactorGracePeriod,omitempty"`
 	PerProjectPermissions bool    `json:"perProjectPermissions"`
 	EmailVerification     bool    `json:"emailVerification"`
 	Modules               Modules `json:"modules"`
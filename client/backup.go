@@ -0,0 +1,50 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"terraform-provider-teamcity/models"
+)
+
+// StartBackup starts a server backup and returns the name of the backup file.
+func (c *Client) StartBackup(options models.BackupOptions) (string, error) {
+	addr, err := c.verifyRequestAddr("/server/backup")
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("fileName", options.FileName)
+	query.Set("addTimestamp", strconv.FormatBool(options.AddTimestamp))
+	query.Set("includeConfigs", strconv.FormatBool(options.IncludeConfigs))
+	query.Set("includeDatabase", strconv.FormatBool(options.IncludeDatabase))
+	query.Set("includeBuildLogs", strconv.FormatBool(options.IncludeBuildLogs))
+	query.Set("includePersonalChanges", strconv.FormatBool(options.IncludePersonalChanges))
+	query.Set("includeRunningBuilds", strconv.FormatBool(options.IncludeRunningBuilds))
+	// the parameter name is misspelled in the REST API
+	query.Set("includeSupplimentaryData", strconv.FormatBool(options.IncludeSupplementaryData))
+	addr.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, addr.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := c.requestWithType(req, "text/plain")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(response.Body)), nil
+}
+
+// GetBackupStatus returns the state of the backup process, e.g. Idle or Running.
+func (c *Client) GetBackupStatus() (string, error) {
+	status, err := c.GetTextRequest("/server/backup", "")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(status), nil
+}
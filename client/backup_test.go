@@ -0,0 +1,62 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-teamcity/models"
+	"testing"
+)
+
+func TestBackup(t *testing.T) {
+	t.Run("start backup", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/server/backup" || r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			q := r.URL.Query()
+			if q.Get("fileName") != "pre_apply" || q.Get("includeConfigs") != "true" || q.Get("includeBuildLogs") != "false" || q.Get("includeSupplimentaryData") != "true" {
+				t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("pre_apply_20250101_120000.zip\n"))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		file, err := httpClient.StartBackup(models.BackupOptions{
+			FileName:                 "pre_apply",
+			AddTimestamp:             true,
+			IncludeConfigs:           true,
+			IncludeDatabase:          true,
+			IncludeSupplementaryData: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if file != "pre_apply_20250101_120000.zip" {
+			t.Fatalf("unexpected file name: %q", file)
+		}
+	})
+
+	t.Run("backup status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/server/backup" || r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("Running"))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		status, err := httpClient.GetBackupStatus()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if status != "Running" {
+			t.Fatalf("unexpected status: %q", status)
+		}
+	})
+}
@@ -58,3 +58,28 @@ func (c *Client) UpdateBuildType(id string, bt models.BuildTypeJson) (*models.Bu
 func (c *Client) DeleteBuildType(id string) error {
 	return c.DeleteRequest(fmt.Sprintf("/buildTypes/id:%s", id))
 }
+
+const buildTypeDetailsFields = "fields=id,name,type,projectId,project(id),paused,description," +
+	"templates(buildType(id))," +
+	"parameters(property(name,value,type(rawValue)))," +
+	"steps(step(id,name,type,properties(property(name,value))))," +
+	"triggers(trigger(id,type,properties(property(name,value))))," +
+	"features(feature(id,type,properties(property(name,value))))," +
+	"agent-requirements(agent-requirement(id,type,properties(property(name,value))))," +
+	"snapshot-dependencies(snapshot-dependency(id,type,source-buildType(id),properties(property(name,value))))," +
+	"artifact-dependencies(artifact-dependency(id,type,source-buildType(id),properties(property(name,value))))," +
+	"vcs-root-entries(vcs-root-entry(id,vcs-root(id),checkout-rules))"
+
+// GetBuildTypeDetails reads a build configuration with its steps, triggers,
+// features, requirements, dependencies, VCS roots, parameters and templates.
+func (c *Client) GetBuildTypeDetails(id string) (*models.BuildTypeDetailsJson, error) {
+	var actual models.BuildTypeDetailsJson
+	err := c.GetRequest(fmt.Sprintf("/buildTypes/id:%s", id), buildTypeDetailsFields, &actual)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &actual, nil
+}
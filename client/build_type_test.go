@@ -0,0 +1,58 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBuildTypeDetails(t *testing.T) {
+	const detailsJSON = `{"id":"BC","name":"BC","projectId":"P",` +
+		`"templates":{"buildType":[{"id":"Tpl"}]},` +
+		`"parameters":{"property":[{"name":"a","value":"1"},{"name":"s","value":"","type":{"rawValue":"password display='normal'"}}]},` +
+		`"steps":{"step":[{"id":"RUNNER_1","name":"build","type":"simpleRunner","properties":{"property":[{"name":"script.content","value":"make"}]}}]},` +
+		`"snapshot-dependencies":{"snapshot-dependency":[{"id":"Dep","type":"snapshot_dependency","source-buildType":{"id":"Dep"}}]},` +
+		`"vcs-root-entries":{"vcs-root-entry":[{"id":"Root","vcs-root":{"id":"Root"},"checkout-rules":"+:src"}]}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/app/rest/buildTypes/id:BC" {
+			t.Fatal(fmt.Errorf("wrong url path: %s", r.URL.Path))
+		}
+		if r.URL.RawQuery != buildTypeDetailsFields {
+			t.Fatal(fmt.Errorf("wrong query: %s, expected: %s", r.URL.RawQuery, buildTypeDetailsFields))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(detailsJSON))
+	}))
+	defer server.Close()
+
+	httpClient := NewClient(server.URL, "token", "", "", 12)
+
+	bt, err := httpClient.GetBuildTypeDetails("BC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bt.ID != "BC" || bt.GetProjectID() != "P" {
+		t.Fatalf("unexpected build type: %+v", bt.BuildTypeJson)
+	}
+	if len(bt.Templates.BuildType) != 1 || bt.Templates.BuildType[0].ID != "Tpl" {
+		t.Fatalf("unexpected templates: %+v", bt.Templates)
+	}
+	if len(bt.Parameters.Property) != 2 || bt.Parameters.Property[1].Type.RawValue != "password display='normal'" {
+		t.Fatalf("unexpected parameters: %+v", bt.Parameters)
+	}
+	if len(bt.Steps.Step) != 1 || bt.Steps.Step[0].Properties.Property[0].Value != "make" {
+		t.Fatalf("unexpected steps: %+v", bt.Steps)
+	}
+	if bt.SnapshotDependencies.SnapshotDependency[0].SourceBuildType.ID != "Dep" {
+		t.Fatalf("unexpected snapshot dependencies: %+v", bt.SnapshotDependencies)
+	}
+	if entry := bt.VcsRootEntries.VcsRootEntry[0]; *entry.VcsRoot.ID != "Root" || entry.CheckoutRules != "+:src" {
+		t.Fatalf("unexpected vcs root entries: %+v", bt.VcsRootEntries)
+	}
+	if bt.Triggers != nil {
+		t.Fatalf("expected no triggers, got %+v", bt.Triggers)
+	}
+}
@@ -71,6 +71,17 @@ func (c *Client) GetGroupByName(name string) (*models.GroupJson, error) {
 	return &group, nil
 }
 
+const groupsFields = "fields=group(key,name,description,parent-groups(group(key)),child-groups(group(key)),users(user(username)),roles(role(roleId,scope)))"
+
+// GetGroups returns all user groups with their members, parents and children.
+func (c *Client) GetGroups() ([]models.GroupJson, error) {
+	var actual models.GroupsJson
+	if err := c.GetRequest("/userGroups", groupsFields, &actual); err != nil {
+		return nil, err
+	}
+	return actual.Group, nil
+}
+
 func (c *Client) DeleteGroup(id string) error {
 	return c.DeleteRequest(fmt.Sprintf("/userGroups/%s", id))
 }
@@ -134,6 +145,27 @@ func (c *Client) CheckGroupMember(groupId, username string) (bool, error) {
 	return true, nil
 }
 
+// GetGroupMembers returns the usernames of the direct members of the group, nil if the group doesn't exist.
+func (c *Client) GetGroupMembers(groupId string) ([]string, error) {
+	var group models.GroupJson
+	err := c.GetRequest(fmt.Sprintf("/userGroups/%s", groupId), "fields=users(user(username))", &group)
+
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{}
+	if group.Users != nil {
+		for _, u := range group.Users.User {
+			members = append(members, u.Username)
+		}
+	}
+	return members, nil
+}
+
 func (c *Client) DeleteGroupMember(groupId, username string) error {
 	return c.DeleteRequest(fmt.Sprintf("/users/username:%s/groups/%s", username, groupId))
 }
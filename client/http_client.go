@@ -19,6 +19,10 @@ import (
 // ErrNotFound for special cases instead of always returning http statusCode.
 var ErrNotFound = errors.New("not found")
 
+// ErrMaintenance is returned while the server answers with 503, i.e. it is
+// starting, upgrading or waiting for an administrator in the maintenance page.
+var ErrMaintenance = errors.New("server is in maintenance mode")
+
 const requestsTimeoutSec = 30
 
 type Client struct {
@@ -109,6 +113,10 @@ func (c *Client) requestWithType(req *http.Request, ct string) (Response, error)
 		return Response{}, ErrNotFound
 	}
 
+	if res.StatusCode == http.StatusServiceUnavailable {
+		return Response{}, fmt.Errorf("%w, status: %d, body: %s", ErrMaintenance, res.StatusCode, body)
+	}
+
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted {
 		return Response{}, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
 	}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// InternalPropertiesAvailable reports whether the server manages internal
+// properties through the REST API, older servers only read them from the
+// internal.properties file.
+func (c *Client) InternalPropertiesAvailable() (bool, error) {
+	err := c.GetRequest("/server/internalProperties", "", nil)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetInternalProperty returns the value of an internal property, nil when the
+// property is not set.
+func (c *Client) GetInternalProperty(name string) (*string, error) {
+	value, err := c.GetTextRequest("/server/internalProperties/"+url.PathEscape(name), "")
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// SetInternalProperty sets an internal property, the server applies it
+// without a restart.
+func (c *Client) SetInternalProperty(name, value string) error {
+	req, err := http.NewRequest(
+		http.MethodPut,
+		fmt.Sprintf("%s/server/internalProperties/%s", c.RestURL, url.PathEscape(name)),
+		strings.NewReader(value),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.retryableRequestWithType(req, "text/plain", retryPolicy)
+	return err
+}
+
+// DeleteInternalProperty removes an internal property, the server falls back
+// to its default value.
+func (c *Client) DeleteInternalProperty(name string) error {
+	return c.DeleteRequest("/server/internalProperties/" + url.PathEscape(name))
+}
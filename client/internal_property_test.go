@@ -0,0 +1,91 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInternalProperties(t *testing.T) {
+	properties := map[string]string{"teamcity.vcs.checkInterval": "60"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/app/rest/server/internalProperties"
+		if r.URL.Path == prefix && r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count":1,"property":[{"name":"teamcity.vcs.checkInterval","value":"60"}]}`))
+			return
+		}
+		name := r.URL.Path[len(prefix)+1:]
+		switch r.Method {
+		case http.MethodGet:
+			value, ok := properties[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(value))
+		case http.MethodPut:
+			if r.Header.Get("Content-Type") != "text/plain" {
+				t.Fatalf("unexpected content type: %s", r.Header.Get("Content-Type"))
+			}
+			body, _ := io.ReadAll(r.Body)
+			properties[name] = string(body)
+			w.WriteHeader(http.StatusOK)
+			w.Write(body)
+		case http.MethodDelete:
+			delete(properties, name)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	httpClient := NewClient(server.URL, "token", "", "", 12)
+
+	t.Run("available", func(t *testing.T) {
+		available, err := httpClient.InternalPropertiesAvailable()
+		if err != nil || !available {
+			t.Fatalf("expected internal properties, got %v, %v", available, err)
+		}
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		if err := httpClient.SetInternalProperty("teamcity.http.proxyHost", "proxy.example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		value, err := httpClient.GetInternalProperty("teamcity.http.proxyHost")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value == nil || *value != "proxy.example.com" {
+			t.Fatalf("unexpected value: %v", value)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := httpClient.DeleteInternalProperty("teamcity.vcs.checkInterval"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		value, err := httpClient.GetInternalProperty("teamcity.vcs.checkInterval")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if value != nil {
+			t.Fatalf("expected no value, got %q", *value)
+		}
+	})
+}
+
+func TestInternalPropertiesNotAvailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	httpClient := NewClient(server.URL, "token", "", "", 12)
+	available, err := httpClient.InternalPropertiesAvailable()
+	if err != nil || available {
+		t.Fatalf("expected no internal properties, got %v, %v", available, err)
+	}
+}
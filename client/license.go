@@ -1,9 +1,12 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
+	"terraform-provider-teamcity/models"
 )
 
 func (c *Client) NewLicense(key string) error {
@@ -24,24 +27,6 @@ func (c *Client) NewLicense(key string) error {
 	return nil
 }
 
-func (c *Client) CheckLicense(key string) (bool, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/server/licensingData/licenseKeys/%s", c.RestURL, key), nil)
-	if err != nil {
-		return false, err
-	}
-
-	resp, err := c.request(req)
-	if err != nil {
-		return false, err
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return false, nil
-	}
-
-	return true, nil
-}
-
 func (c *Client) DeleteLicense(key string) error {
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/server/licensingData/licenseKeys/%s", c.RestURL, key), nil)
 	if err != nil {
@@ -55,3 +40,26 @@ func (c *Client) DeleteLicense(key string) error {
 
 	return nil
 }
+
+// GetLicense returns the details of an installed license key, nil when the
+// key is not installed.
+func (c *Client) GetLicense(key string) (*models.LicenseKeyJson, error) {
+	var actual models.LicenseKeyJson
+	err := c.GetRequest("/server/licensingData/licenseKeys/"+url.PathEscape(key), "", &actual)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &actual, nil
+}
+
+// GetLicenses returns all installed license keys.
+func (c *Client) GetLicenses() ([]models.LicenseKeyJson, error) {
+	var actual models.LicenseKeysJson
+	if err := c.GetRequest("/server/licensingData/licenseKeys", "", &actual); err != nil {
+		return nil, err
+	}
+	return actual.LicenseKey, nil
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLicenses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/app/rest/server/licensingData/licenseKeys":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count":1,"licenseKey":[{"key":"12345","type":"commercial","valid":true,"agents":10,"expirationDate":"20261118T000000+0000"}]}`))
+		case "/app/rest/server/licensingData/licenseKeys/12345":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"key":"12345","type":"commercial","valid":true,"agents":10,"expirationDate":"20261118T000000+0000"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	httpClient := NewClient(server.URL, "token", "", "", 12)
+
+	t.Run("get license", func(t *testing.T) {
+		actual, err := httpClient.GetLicense("12345")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual == nil || actual.Agents != 10 || actual.ExpirationDate != "20261118T000000+0000" {
+			t.Fatalf("unexpected license: %#v", actual)
+		}
+	})
+
+	t.Run("missing license", func(t *testing.T) {
+		actual, err := httpClient.GetLicense("67890")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual != nil {
+			t.Fatalf("expected no license, got %#v", actual)
+		}
+	})
+
+	t.Run("get licenses", func(t *testing.T) {
+		actual, err := httpClient.GetLicenses()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(actual) != 1 || actual[0].Key != "12345" {
+			t.Fatalf("unexpected licenses: %#v", actual)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"terraform-provider-teamcity/models"
+)
+
+// UserNotificationRules returns the endpoint holding notification rules of the user with the given id.
+func UserNotificationRules(userId string) string {
+	return fmt.Sprintf("/users/id:%s/notificationRules", userId)
+}
+
+// GroupNotificationRules returns the endpoint holding notification rules of the group with the given key.
+func GroupNotificationRules(groupKey string) string {
+	return fmt.Sprintf("/userGroups/%s/notificationRules", groupKey)
+}
+
+func (c *Client) NewNotificationRule(endpoint string, rule models.NotificationRuleJson) (*models.NotificationRuleJson, error) {
+	rb, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	var actual models.NotificationRuleJson
+	err = c.PostRequest(endpoint, bytes.NewReader(rb), &actual)
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
+
+func (c *Client) GetNotificationRule(endpoint, id string) (*models.NotificationRuleJson, error) {
+	var actual models.NotificationRuleJson
+	err := c.GetRequest(fmt.Sprintf("%s/id:%s", endpoint, id), "", &actual)
+
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
+
+func (c *Client) SetNotificationRule(endpoint, id string, rule models.NotificationRuleJson) (*models.NotificationRuleJson, error) {
+	rb, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	var actual models.NotificationRuleJson
+	err = c.PutRequest(fmt.Sprintf("%s/id:%s", endpoint, id), bytes.NewReader(rb), &actual)
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
+
+func (c *Client) DeleteNotificationRule(endpoint, id string) error {
+	return c.DeleteRequest(fmt.Sprintf("%s/id:%s", endpoint, id))
+}
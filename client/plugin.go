@@ -0,0 +1,14 @@
+package client
+
+import (
+	"terraform-provider-teamcity/models"
+)
+
+// GetPlugins returns the plugins loaded by the server.
+func (c *Client) GetPlugins() ([]models.PluginJson, error) {
+	var actual models.PluginsJson
+	if err := c.GetRequest("/server/plugins", "", &actual); err != nil {
+		return nil, err
+	}
+	return actual.Plugin, nil
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPlugins(t *testing.T) {
+	t.Run("get plugins", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/server/plugins" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count":1,"plugin":[{"name":"slack-notifier","displayName":"Slack Notifier","version":"2024.1","loadPath":"/plugins/slack"}]}`))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		plugins, err := httpClient.GetPlugins()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(plugins) != 1 || plugins[0].Name != "slack-notifier" || plugins[0].Version != "2024.1" {
+			t.Fatalf("unexpected plugins: %#v", plugins)
+		}
+	})
+
+}
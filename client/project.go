@@ -86,6 +86,20 @@ func (c *Client) GetProjectFeature(projectId, featureId string) (*models.Project
 	return &actual, nil
 }
 
+func (c *Client) UpdateProjectFeature(projectId, featureId string, feature models.ProjectFeatureJson) (*models.ProjectFeatureJson, error) {
+	rb, err := json.Marshal(feature)
+	if err != nil {
+		return nil, err
+	}
+
+	var actual models.ProjectFeatureJson
+	endpoint := fmt.Sprintf("/projects/id:%s/projectFeatures/id:%s", projectId, featureId)
+	if err := c.PutRequest(endpoint, bytes.NewReader(rb), &actual); err != nil {
+		return nil, err
+	}
+	return &actual, nil
+}
+
 func (c *Client) DeleteProjectFeature(projectId, featureId string) error {
 	endpoint := fmt.Sprintf("/projects/id:%s/projectFeatures/id:%s", projectId, featureId)
 	return c.DeleteRequest(endpoint)
@@ -110,6 +124,22 @@ func (c *Client) GetVersionedSettings(projectId string) (*models.VersionedSettin
 	return &actual, nil
 }
 
+// GetVersionedSettingsStatus returns the status of the last synchronization, nil if the project is not found.
+func (c *Client) GetVersionedSettingsStatus(projectId string) (*models.VersionedSettingsStatusJson, error) {
+	var actual models.VersionedSettingsStatusJson
+	endpoint := fmt.Sprintf("/projects/id:%s/versionedSettings/status", projectId)
+
+	err := c.GetRequest(endpoint, "", &actual)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
+
 func (c *Client) SetVersionedSettings(projectId string, settings models.VersionedSettingsJson) (*models.VersionedSettingsJson, error) {
 	rb, err := json.Marshal(settings)
 	if err != nil {
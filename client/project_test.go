@@ -332,6 +332,43 @@ func TestProjectFeaturesAndVersionedSettings(t *testing.T) {
 		}
 	})
 
+	t.Run("versioned settings: get status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == objTcEndpoint+"/id:"+objId+"/versionedSettings/status" && r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"type":"warn","message":"Compilation error","timestamp":"20250101T120000+0000","dslOutdated":false}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		actual, err := httpClient.GetVersionedSettingsStatus(objId)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual == nil || actual.Type != "warn" || actual.Message != "Compilation error" {
+			t.Fatalf("unexpected status: %#v", actual)
+		}
+	})
+
+	t.Run("versioned settings: get status 404 returns nil", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		actual, err := httpClient.GetVersionedSettingsStatus(objId)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual != nil {
+			t.Fatalf("expected nil, got: %#v", actual)
+		}
+	})
+
 	t.Run("versioned settings: set success (may call correction path)", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.Path == objTcEndpoint+"/id:"+objId+"/versionedSettings/config" && r.Method == http.MethodPut {
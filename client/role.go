@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 )
 
 type Role struct {
@@ -23,7 +24,13 @@ type Permissions struct {
 }
 
 type Permission struct {
-	Id string `json:"id"`
+	Id     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Global *bool  `json:"global,omitempty"`
+}
+
+type Roles struct {
+	Role []Role `json:"role"`
 }
 
 func (c *Client) NewRole(role Role) (Role, error) {
@@ -164,3 +171,39 @@ func (c *Client) RemovePermission(roleId, permId string) (Role, error) {
 
 	return actual, nil
 }
+
+const rolesFields = "fields=role(id,name,included(role(id)),permissions(permission(id,name,global)))"
+
+// GetRoles returns all roles, built-in and custom, with their own permissions and included roles.
+func (c *Client) GetRoles() ([]Role, error) {
+	var actual Roles
+	if err := c.GetRequest("/roles", rolesFields, &actual); err != nil {
+		return nil, err
+	}
+	return actual.Role, nil
+}
+
+// GetPermissions returns the permissions known to the server. The REST API has no
+// permission list, they are collected from the roles: SYSTEM_ADMIN holds all of them.
+func (c *Client) GetPermissions() ([]Permission, error) {
+	roles, err := c.GetRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var permissions []Permission
+	for _, role := range roles {
+		if role.Permissions == nil {
+			continue
+		}
+		for _, p := range role.Permissions.Permission {
+			if !seen[p.Id] {
+				seen[p.Id] = true
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].Id < permissions[j].Id })
+	return permissions, nil
+}
@@ -1,20 +1,90 @@
 package client
 
 import (
-	"fmt"
-	"net/http"
+	"errors"
+	"net/url"
+	"strconv"
+	"terraform-provider-teamcity/models"
 )
 
-func (c *Client) GetVersion() (string, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/server/version", c.RestURL), nil)
+// GetServer returns the server information. ErrMaintenance is returned while
+// the server is starting, upgrading or waiting for an administrator.
+func (c *Client) GetServer() (*models.ServerJson, error) {
+	var actual models.ServerJson
+	if err := c.GetRequest("/server", "", &actual); err != nil {
+		return nil, err
+	}
+	return &actual, nil
+}
+
+const nodeFields = "id,url,online,role,state,current,enabledResponsibilities(responsibility(name,description)),disabledResponsibilities(responsibility(name,description))"
+
+// GetNodes returns the nodes of a multi-node setup, a single server reports
+// itself as the main node.
+func (c *Client) GetNodes() ([]models.NodeJson, error) {
+	var actual models.NodesJson
+	query := url.Values{"fields": {"count,node(" + nodeFields + ")"}}
+	if err := c.GetRequest("/server/nodes", query.Encode(), &actual); err != nil {
+		return nil, err
+	}
+	return actual.Node, nil
+}
+
+// GetNode returns a node with its responsibilities, nil when there is no
+// node with the id.
+func (c *Client) GetNode(id string) (*models.NodeJson, error) {
+	var actual models.NodeJson
+	query := url.Values{"fields": {nodeFields}}
+	err := c.GetRequest("/server/nodes/id:"+id, query.Encode(), &actual)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return &actual, nil
+}
 
-	body, err := c.doRequest(req)
+// SetNodeResponsibility enables or disables a responsibility of a node.
+func (c *Client) SetNodeResponsibility(id, name string, enabled bool) error {
+	value := strconv.FormatBool(enabled)
+	_, err := c.SetField("server/nodes", id, "enabledResponsibilities/"+name, &value)
+	return err
+}
+
+// GetCurrentNode returns the node serving the requests, ErrNotFound when the
+// server doesn't report one.
+func (c *Client) GetCurrentNode() (*models.NodeJson, error) {
+	nodes, err := c.GetNodes()
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	for i := range nodes {
+		if nodes[i].Current {
+			return &nodes[i], nil
+		}
 	}
+	return nil, ErrNotFound
+}
 
-	return string(body), nil
+// GetLicensingData returns the license usage of the server.
+func (c *Client) GetLicensingData() (*models.LicensingDataJson, error) {
+	var actual models.LicensingDataJson
+	if err := c.GetRequest("/server/licensingData", "", &actual); err != nil {
+		return nil, err
+	}
+	return &actual, nil
+}
+
+// GetHealthItems returns the active server health items.
+func (c *Client) GetHealthItems() ([]models.HealthItemJson, error) {
+	var actual models.HealthItemsJson
+	err := c.GetRequest("/health", "", &actual)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return actual.HealthItem, nil
 }
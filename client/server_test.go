@@ -0,0 +1,152 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServer(t *testing.T) {
+	t.Run("get server", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/server" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"version":"2024.12 (build 174331)","versionMajor":2024,"versionMinor":12,"buildNumber":"174331","startTime":"20250102T030405+0000"}`))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		actual, err := httpClient.GetServer()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual.VersionMajor != 2024 || actual.VersionMinor != 12 || actual.BuildNumber != "174331" {
+			t.Fatalf("unexpected server: %#v", actual)
+		}
+	})
+
+	t.Run("maintenance mode", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`TeamCity is starting`))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		_, err := httpClient.GetServer()
+		if !errors.Is(err, ErrMaintenance) {
+			t.Fatalf("expected ErrMaintenance, got %v", err)
+		}
+	})
+
+	t.Run("current node", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/server/nodes" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count":2,"node":[{"id":"main","role":"main_node","online":true},{"id":"node-2","role":"secondary_node","online":true,"current":true}]}`))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		node, err := httpClient.GetCurrentNode()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if node.Id != "node-2" || node.Role != "secondary_node" {
+			t.Fatalf("unexpected node: %#v", node)
+		}
+	})
+
+	t.Run("node responsibilities", func(t *testing.T) {
+		var changed string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/app/rest/server/nodes/id:node-2":
+				if !strings.Contains(r.URL.Query().Get("fields"), "enabledResponsibilities") {
+					t.Fatalf("responsibilities not requested: %s", r.URL.RawQuery)
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"id":"node-2","role":"secondary_node","enabledResponsibilities":{"count":1,"responsibility":[{"name":"CAN_CHECK_FOR_CHANGES"}]},"disabledResponsibilities":{"count":1,"responsibility":[{"name":"CAN_PROCESS_BUILD_MESSAGES"}]}}`))
+			case r.Method == http.MethodPut && r.URL.Path == "/app/rest/server/nodes/id:node-2/enabledResponsibilities/CAN_PROCESS_BUILD_MESSAGES":
+				body, _ := io.ReadAll(r.Body)
+				changed = string(body)
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		node, err := httpClient.GetNode("node-2")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if node.EnabledResponsibilities.Responsibility[0].Name != "CAN_CHECK_FOR_CHANGES" || node.DisabledResponsibilities.Responsibility[0].Name != "CAN_PROCESS_BUILD_MESSAGES" {
+			t.Fatalf("unexpected node: %#v", node)
+		}
+
+		if err := httpClient.SetNodeResponsibility("node-2", "CAN_PROCESS_BUILD_MESSAGES", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if changed != "true" {
+			t.Fatalf("unexpected body: %q", changed)
+		}
+
+		missing, err := httpClient.GetNode("node-3")
+		if err != nil || missing != nil {
+			t.Fatalf("expected no node, got %#v, %v", missing, err)
+		}
+	})
+
+	t.Run("licensing data", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/server/licensingData" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"licenseUseExceeded":false,"maxAgents":3,"agentsLeft":1,"unlimitedBuildTypes":true,"maxBuildTypes":-1,"buildTypesLeft":-1,"serverLicenseType":"professional"}`))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		actual, err := httpClient.GetLicensingData()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if actual.MaxAgents != 3 || actual.AgentsLeft != 1 || !actual.UnlimitedBuildTypes || actual.ServerLicenseType != "professional" {
+			t.Fatalf("unexpected licensing data: %#v", actual)
+		}
+	})
+
+	t.Run("health items", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/health" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"count":1,"healthItem":[{"identity":"diskSpace","severity":"WARN","healthCategory":{"id":"diskSpace","name":"Low disk space"}}]}`))
+		}))
+		defer server.Close()
+
+		httpClient := NewClient(server.URL, "token", "", "", 12)
+		items, err := httpClient.GetHealthItems()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(items) != 1 || items[0].Severity != "WARN" || items[0].HealthCategory.Name != "Low disk space" {
+			t.Fatalf("unexpected health items: %#v", items)
+		}
+	})
+}
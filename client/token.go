@@ -0,0 +1,48 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"terraform-provider-teamcity/models"
+)
+
+const tokenFields = "fields=name,creationTime,expirationTime,permissionRestrictions(permissionRestriction(isGlobalScope,project(id),permission(id)))"
+
+// NewUserToken creates an access token for the user, the returned token holds the secret value.
+func (c *Client) NewUserToken(userId string, token models.TokenJson) (*models.TokenJson, error) {
+	rb, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var actual models.TokenJson
+	err = c.PostRequest(fmt.Sprintf("/users/id:%s/tokens", userId), bytes.NewReader(rb), &actual)
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
+
+// GetUserToken returns the token without its value, nil if the token or the user doesn't exist.
+func (c *Client) GetUserToken(userId, name string) (*models.TokenJson, error) {
+	var actual models.TokenJson
+	err := c.GetRequest(fmt.Sprintf("/users/id:%s/tokens/%s", userId, url.PathEscape(name)), tokenFields, &actual)
+
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
+
+func (c *Client) DeleteUserToken(userId, name string) error {
+	return c.DeleteRequest(fmt.Sprintf("/users/id:%s/tokens/%s", userId, url.PathEscape(name)))
+}
@@ -5,17 +5,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"terraform-provider-teamcity/models"
 )
 
 type User struct {
 	Id         *int64             `json:"id,omitempty"`
 	Username   string             `json:"username"`
+	Name       *string            `json:"name,omitempty"`
+	Email      *string            `json:"email,omitempty"`
 	Password   *string            `json:"password,omitempty"`
+	LastLogin  string             `json:"lastLogin,omitempty"`
 	Roles      *RoleAssignments   `json:"roles,omitempty"`
+	Groups     *UserGroups        `json:"groups,omitempty"`
 	Properties *models.Properties `json:"properties,omitempty"`
 }
 
+type UserGroups struct {
+	Group []models.GroupJson `json:"group"`
+}
+
 type RoleAssignments struct {
 	RoleAssignment []RoleAssignment `json:"role"`
 }
@@ -134,3 +143,36 @@ func (c *Client) DeleteUser(id string) error {
 
 	return nil
 }
+
+type Users struct {
+	User []User `json:"user"`
+}
+
+const usersFields = "fields=user(id,username,name,email,lastLogin,roles(role(roleId,scope)),groups(group(key)))"
+
+// GetUsers returns the users matching the locator, all users if the locator is empty.
+func (c *Client) GetUsers(locator string) ([]User, error) {
+	query := usersFields
+	if locator != "" {
+		query = "locator=" + url.QueryEscape(locator) + "&" + usersFields
+	}
+
+	var actual Users
+	if err := c.GetRequest("/users", query, &actual); err != nil {
+		return nil, err
+	}
+	return actual.User, nil
+}
+
+func (c *Client) AddUserRole(username, roleId, scope string) error {
+	rb, err := json.Marshal(RoleAssignment{Id: roleId, Scope: scope})
+	if err != nil {
+		return err
+	}
+
+	return c.PostRequest(fmt.Sprintf("/users/username:%s/roles", url.PathEscape(username)), bytes.NewReader(rb), nil)
+}
+
+func (c *Client) RemoveUserRole(username, roleId, scope string) error {
+	return c.DeleteRequest(fmt.Sprintf("/users/username:%s/roles/%s/%s", url.PathEscape(username), roleId, scope))
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUsers(t *testing.T) {
+	const usersJSON = `{"user":[{"id":1,"username":"admin","email":"admin@example.com","lastLogin":"20240115T101112+0000","roles":{"role":[{"roleId":"SYSTEM_ADMIN","scope":"g"}]}}]}`
+	const locator = "lastLogin:(date:20240115T100000+0000,condition:before)"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/app/rest/users" {
+			t.Fatal(fmt.Errorf("wrong url path: %s", r.URL.Path))
+		}
+		if r.URL.Query().Get("locator") != locator {
+			t.Fatal(fmt.Errorf("wrong locator: %s, expected: %s", r.URL.Query().Get("locator"), locator))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(usersJSON))
+	}))
+	defer server.Close()
+
+	httpClient := NewClient(server.URL, "token", "", "", 12)
+
+	users, err := httpClient.GetUsers(locator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].Username != "admin" || *users[0].Email != "admin@example.com" {
+		t.Fatalf("unexpected users: %+v", users)
+	}
+	if users[0].LastLogin != "20240115T101112+0000" || users[0].Roles.RoleAssignment[0].Id != "SYSTEM_ADMIN" {
+		t.Fatalf("unexpected user details: %+v", users[0])
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"terraform-provider-teamcity/models"
@@ -15,6 +16,7 @@ type VcsRoot struct {
 	PollingInterval *int              `json:"modificationCheckInterval,omitempty"`
 	Project         ProjectLocator    `json:"project"`
 	Properties      models.Properties `json:"properties"`
+	InternalId      *int64            `json:"internalId,omitempty"`
 }
 
 func (c *Client) NewVcsRoot(p VcsRoot) (VcsRoot, error) {
@@ -110,3 +112,18 @@ func (c *Client) DetachVcsRoot(id string) error {
 
 	return nil
 }
+
+// GetVcsRootInternalId returns the internal ID and the VCS type of the root, nil if the root doesn't exist.
+func (c *Client) GetVcsRootInternalId(id string) (*VcsRoot, error) {
+	var actual VcsRoot
+	err := c.GetRequest(fmt.Sprintf("/vcs-roots/id:%s", id), "fields=id,internalId,vcsName", &actual)
+
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &actual, nil
+}
@@ -0,0 +1,13 @@
+package models
+
+// BackupOptions are the query parameters of POST /server/backup.
+type BackupOptions struct {
+	FileName                 string
+	AddTimestamp             bool
+	IncludeConfigs           bool
+	IncludeDatabase          bool
+	IncludeBuildLogs         bool
+	IncludePersonalChanges   bool
+	IncludeRunningBuilds     bool
+	IncludeSupplementaryData bool
+}
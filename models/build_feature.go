@@ -9,8 +9,56 @@ type BuildFeatureJson struct {
 }
 
 type BuildFeatureDataModel struct {
-	ID                   types.String `tfsdk:"id"`
-	BuildConfigurationId types.String `tfsdk:"build_configuration_id"`
-	Type                 types.String `tfsdk:"type"`
-	Properties           types.Map    `tfsdk:"properties"`
+	ID                    types.String                `tfsdk:"id"`
+	BuildConfigurationId  types.String                `tfsdk:"build_configuration_id"`
+	Type                  types.String                `tfsdk:"type"`
+	Properties            types.Map                   `tfsdk:"properties"`
+	CommitStatusPublisher *CommitStatusPublisherModel `tfsdk:"commit_status_publisher"`
+	PullRequests          *PullRequestsModel          `tfsdk:"pull_requests"`
+	DockerSupport         *DockerSupportModel         `tfsdk:"docker_support"`
+	Perfmon               *PerfmonModel               `tfsdk:"perfmon"`
+	XmlReport             *XmlReportModel             `tfsdk:"xml_report"`
+	SshAgent              *SshAgentModel              `tfsdk:"ssh_agent"`
+}
+
+type CommitStatusPublisherModel struct {
+	Publisher   types.String `tfsdk:"publisher"`
+	VcsRootId   types.String `tfsdk:"vcs_root_id"`
+	ServerUrl   types.String `tfsdk:"server_url"`
+	AuthType    types.String `tfsdk:"auth_type"`
+	Username    types.String `tfsdk:"username"`
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenId     types.String `tfsdk:"token_id"`
+}
+
+type PullRequestsModel struct {
+	Provider           types.String `tfsdk:"provider"`
+	VcsRootId          types.String `tfsdk:"vcs_root_id"`
+	ServerUrl          types.String `tfsdk:"server_url"`
+	AuthType           types.String `tfsdk:"auth_type"`
+	Username           types.String `tfsdk:"username"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	TokenId            types.String `tfsdk:"token_id"`
+	FilterSourceBranch types.String `tfsdk:"filter_source_branch"`
+	FilterTargetBranch types.String `tfsdk:"filter_target_branch"`
+	FilterAuthorRole   types.String `tfsdk:"filter_author_role"`
+	IgnoreDrafts       types.Bool   `tfsdk:"ignore_drafts"`
+}
+
+type DockerSupportModel struct {
+	CleanupPushed   types.Bool     `tfsdk:"cleanup_pushed"`
+	LoginToRegistry []types.String `tfsdk:"login_to_registry"`
+}
+
+type PerfmonModel struct{}
+
+type XmlReportModel struct {
+	ReportType types.String   `tfsdk:"report_type"`
+	Rules      []types.String `tfsdk:"rules"`
+	Verbose    types.Bool     `tfsdk:"verbose"`
+}
+
+type SshAgentModel struct {
+	SshKey     types.String `tfsdk:"ssh_key"`
+	Passphrase types.String `tfsdk:"passphrase"`
 }
@@ -31,3 +31,60 @@ type BuildTypeDataModel struct {
 	BuildType   types.String `tfsdk:"build_type"`
 	Paused      types.Bool   `tfsdk:"paused"`
 }
+
+// BuildTypeDetailsJson is a build configuration together with its settings,
+// as returned by the REST API when the nested collections are requested.
+type BuildTypeDetailsJson struct {
+	BuildTypeJson
+	Templates            *BuildTypesJson           `json:"templates,omitempty"`
+	Parameters           *ParametersJson           `json:"parameters,omitempty"`
+	Steps                *BuildStepsJson           `json:"steps,omitempty"`
+	Triggers             *BuildTriggersJson        `json:"triggers,omitempty"`
+	Features             *BuildFeaturesJson        `json:"features,omitempty"`
+	AgentRequirements    *AgentRequirementsJson    `json:"agent-requirements,omitempty"`
+	SnapshotDependencies *SnapshotDependenciesJson `json:"snapshot-dependencies,omitempty"`
+	ArtifactDependencies *ArtifactDependenciesJson `json:"artifact-dependencies,omitempty"`
+	VcsRootEntries       *VcsRootEntriesJson       `json:"vcs-root-entries,omitempty"`
+}
+
+type ParametersJson struct {
+	Property []ParameterJson `json:"property"`
+}
+
+type ParameterJson struct {
+	Name  string             `json:"name"`
+	Value string             `json:"value"`
+	Type  *ParameterTypeJson `json:"type,omitempty"`
+}
+
+type ParameterTypeJson struct {
+	RawValue string `json:"rawValue"`
+}
+
+type BuildStepsJson struct {
+	Step []BuildStepJson `json:"step"`
+}
+
+type BuildTriggersJson struct {
+	Trigger []BuildTriggerJson `json:"trigger"`
+}
+
+type BuildFeaturesJson struct {
+	Feature []BuildFeatureJson `json:"feature"`
+}
+
+type AgentRequirementsJson struct {
+	AgentRequirement []AgentRequirementJson `json:"agent-requirement"`
+}
+
+type SnapshotDependenciesJson struct {
+	SnapshotDependency []SnapshotDependencyJson `json:"snapshot-dependency"`
+}
+
+type ArtifactDependenciesJson struct {
+	ArtifactDependency []ArtifactDependencyJson `json:"artifact-dependency"`
+}
+
+type VcsRootEntriesJson struct {
+	VcsRootEntry []VcsRootEntryJson `json:"vcs-root-entry"`
+}
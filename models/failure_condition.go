@@ -0,0 +1,34 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Failure conditions are stored by TeamCity as build features of these types.
+const (
+	FailureOnMetricType  = "BuildFailureOnMetric"
+	FailureOnMessageType = "BuildFailureOnMessage"
+)
+
+type FailureConditionDataModel struct {
+	ID                   types.String          `tfsdk:"id"`
+	BuildConfigurationId types.String          `tfsdk:"build_configuration_id"`
+	MetricChange         *MetricChangeModel    `tfsdk:"metric_change"`
+	BuildLogPattern      *BuildLogPatternModel `tfsdk:"build_log_pattern"`
+}
+
+type MetricChangeModel struct {
+	Metric     types.String `tfsdk:"metric"`
+	Threshold  types.Int64  `tfsdk:"threshold"`
+	Units      types.String `tfsdk:"units"`
+	Comparison types.String `tfsdk:"comparison"`
+	CompareTo  types.String `tfsdk:"compare_to"`
+	BuildTag   types.String `tfsdk:"build_tag"`
+	StopBuild  types.Bool   `tfsdk:"stop_build"`
+}
+
+type BuildLogPatternModel struct {
+	Pattern        types.String `tfsdk:"pattern"`
+	PatternType    types.String `tfsdk:"pattern_type"`
+	FailOn         types.String `tfsdk:"fail_on"`
+	FailureMessage types.String `tfsdk:"failure_message"`
+	StopBuild      types.Bool   `tfsdk:"stop_build"`
+}
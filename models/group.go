@@ -10,6 +10,20 @@ type GroupJson struct {
 	Description string               `json:"description,omitempty"`
 	Roles       *RoleAssignmentsJson `json:"roles,omitempty"`
 	Parents     *ParentGroupsJson    `json:"parent-groups,omitempty"`
+	Children    *ParentGroupsJson    `json:"child-groups,omitempty"`
+	Users       *GroupUsersJson      `json:"users,omitempty"`
+}
+
+type GroupsJson struct {
+	Group []GroupJson `json:"group"`
+}
+
+type GroupUsersJson struct {
+	User []GroupUserJson `json:"user"`
+}
+
+type GroupUserJson struct {
+	Username string `json:"username"`
 }
 
 type RoleAssignmentsJson struct {
@@ -0,0 +1,22 @@
+package models
+
+type LicenseKeysJson struct {
+	Count      int              `json:"count"`
+	LicenseKey []LicenseKeyJson `json:"licenseKey"`
+}
+
+type LicenseKeyJson struct {
+	Key                 string `json:"key"`
+	Type                string `json:"type"`
+	Valid               bool   `json:"valid"`
+	Active              bool   `json:"active"`
+	Expired             bool   `json:"expired"`
+	Obsolete            bool   `json:"obsolete"`
+	ExpirationDate      string `json:"expirationDate,omitempty"`
+	MaintenanceEndDate  string `json:"maintenanceEndDate,omitempty"`
+	Agents              int64  `json:"agents"`
+	UnlimitedAgents     bool   `json:"unlimitedAgents"`
+	BuildTypes          int64  `json:"buildTypes"`
+	UnlimitedBuildTypes bool   `json:"unlimitedBuildTypes"`
+	ErrorDetails        string `json:"errorDetails,omitempty"`
+}
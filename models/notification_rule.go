@@ -0,0 +1,31 @@
+package models
+
+type NotificationRulesJson struct {
+	NotificationRule []NotificationRuleJson `json:"notificationRule,omitempty"`
+}
+
+type NotificationRuleJson struct {
+	Id         *int64                  `json:"id,omitempty"`
+	Notifier   string                  `json:"notifier"`
+	Watched    NotificationWatchedJson `json:"watchedBuilds"`
+	Events     NotificationEventsJson  `json:"events"`
+	Properties *Properties             `json:"properties,omitempty"`
+}
+
+type NotificationWatchedJson struct {
+	Project      *ProjectJson    `json:"project,omitempty"`
+	BuildTypes   *BuildTypesJson `json:"buildTypes,omitempty"`
+	BranchFilter string          `json:"branchFilter,omitempty"`
+}
+
+type NotificationEventsJson struct {
+	BuildStarted              bool `json:"buildStarted"`
+	BuildFailedToStart        bool `json:"buildFailedToStart"`
+	BuildFailed               bool `json:"buildFailed"`
+	FirstFailureAfterSuccess  bool `json:"firstFailureAfterSuccess"`
+	BuildFinishedSuccessfully bool `json:"buildFinishedSuccessfully"`
+	FirstSuccessAfterFailure  bool `json:"firstSuccessAfterFailure"`
+	BuildProbablyHanging      bool `json:"buildProbablyHanging"`
+	ResponsibilityAssigned    bool `json:"responsibilityAssigned"`
+	ResponsibilityChanged     bool `json:"responsibilityChanged"`
+}
@@ -0,0 +1,13 @@
+package models
+
+type PluginsJson struct {
+	Count  int          `json:"count"`
+	Plugin []PluginJson `json:"plugin"`
+}
+
+type PluginJson struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Version     string `json:"version"`
+	LoadPath    string `json:"loadPath"`
+}
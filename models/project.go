@@ -40,6 +40,14 @@ type VersionedSettingsJson struct {
 	BuildSettingsMode           *string `json:"buildSettingsMode"`
 	ShowSettingsChanges         *bool   `json:"showSettingsChanges"`
 	ImportDecision              *string `json:"importDecision"`
+	SettingsPath                *string `json:"settingsPath,omitempty"`
+}
+
+type VersionedSettingsStatusJson struct {
+	Type        string `json:"type"`
+	Message     string `json:"message"`
+	Timestamp   string `json:"timestamp"`
+	DslOutdated bool   `json:"dslOutdated"`
 }
 
 type VersionedSettingsModel struct {
@@ -48,4 +56,13 @@ type VersionedSettingsModel struct {
 	AllowUIEditing types.Bool   `tfsdk:"allow_ui_editing"`
 	Settings       types.String `tfsdk:"settings"`
 	ShowChanges    types.Bool   `tfsdk:"show_changes"`
+	Format         types.String `tfsdk:"format"`
+	SecureOutside  types.Bool   `tfsdk:"store_secure_values_outside_vcs"`
+	ImportDecision types.String `tfsdk:"import_decision"`
+	SettingsPath   types.String `tfsdk:"settings_path"`
+	SyncStatus     types.String `tfsdk:"sync_status"`
+	SyncMessage    types.String `tfsdk:"sync_message"`
+	LastError      types.String `tfsdk:"last_error"`
+	WaitForSync    types.Bool   `tfsdk:"wait_for_sync"`
+	SyncTimeout    types.String `tfsdk:"sync_timeout"`
 }
@@ -0,0 +1,65 @@
+package models
+
+type ServerJson struct {
+	Version      string `json:"version"`
+	VersionMajor int64  `json:"versionMajor"`
+	VersionMinor int64  `json:"versionMinor"`
+	BuildNumber  string `json:"buildNumber"`
+	BuildDate    string `json:"buildDate"`
+	StartTime    string `json:"startTime"`
+	CurrentTime  string `json:"currentTime"`
+	WebUrl       string `json:"webUrl"`
+}
+
+type NodesJson struct {
+	Count int        `json:"count"`
+	Node  []NodeJson `json:"node"`
+}
+
+type NodeJson struct {
+	Id                       string                `json:"id"`
+	Url                      string                `json:"url,omitempty"`
+	Online                   bool                  `json:"online"`
+	Role                     string                `json:"role,omitempty"`
+	State                    string                `json:"state,omitempty"`
+	Current                  bool                  `json:"current"`
+	EnabledResponsibilities  *ResponsibilitiesJson `json:"enabledResponsibilities,omitempty"`
+	DisabledResponsibilities *ResponsibilitiesJson `json:"disabledResponsibilities,omitempty"`
+}
+
+type ResponsibilitiesJson struct {
+	Count          int                  `json:"count"`
+	Responsibility []ResponsibilityJson `json:"responsibility"`
+}
+
+type ResponsibilityJson struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type LicensingDataJson struct {
+	LicenseUseExceeded  bool   `json:"licenseUseExceeded"`
+	MaxAgents           int64  `json:"maxAgents"`
+	UnlimitedAgents     bool   `json:"unlimitedAgents"`
+	AgentsLeft          int64  `json:"agentsLeft"`
+	MaxBuildTypes       int64  `json:"maxBuildTypes"`
+	UnlimitedBuildTypes bool   `json:"unlimitedBuildTypes"`
+	BuildTypesLeft      int64  `json:"buildTypesLeft"`
+	ServerLicenseType   string `json:"serverLicenseType"`
+}
+
+type HealthItemsJson struct {
+	Count      int              `json:"count"`
+	HealthItem []HealthItemJson `json:"healthItem"`
+}
+
+type HealthItemJson struct {
+	Identity       string              `json:"identity"`
+	Severity       string              `json:"severity"`
+	HealthCategory *HealthCategoryJson `json:"healthCategory,omitempty"`
+}
+
+type HealthCategoryJson struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
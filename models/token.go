@@ -0,0 +1,27 @@
+package models
+
+type TokenJson struct {
+	Name                   string                      `json:"name"`
+	Value                  string                      `json:"value,omitempty"`
+	CreationTime           string                      `json:"creationTime,omitempty"`
+	ExpirationTime         string                      `json:"expirationTime,omitempty"`
+	PermissionRestrictions *PermissionRestrictionsJson `json:"permissionRestrictions,omitempty"`
+}
+
+type PermissionRestrictionsJson struct {
+	PermissionRestriction []PermissionRestrictionJson `json:"permissionRestriction"`
+}
+
+type PermissionRestrictionJson struct {
+	IsGlobalScope bool            `json:"isGlobalScope"`
+	Project       *ProjectRefJson `json:"project,omitempty"`
+	Permission    PermissionJson  `json:"permission"`
+}
+
+type ProjectRefJson struct {
+	Id string `json:"id"`
+}
+
+type PermissionJson struct {
+	Id string `json:"id"`
+}
@@ -2,11 +2,18 @@ package teamcity
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
 	"strconv"
+	"strings"
 	"terraform-provider-teamcity/client"
 	"terraform-provider-teamcity/models"
 )
@@ -27,11 +34,12 @@ type authResource struct {
 }
 
 type authResourceModel struct {
-	AllowGuest        types.Bool   `tfsdk:"allow_guest"`
-	GuestUsername     types.String `tfsdk:"guest_username"`
-	WelcomeText       types.String `tfsdk:"welcome_text"`
-	CollapseLoginForm types.Bool   `tfsdk:"collapse_login_form"`
-	//TwoFactorMode         types.String     `tfsdk:"two_factor_mode"`
+	AllowGuest            types.Bool       `tfsdk:"allow_guest"`
+	GuestUsername         types.String     `tfsdk:"guest_username"`
+	WelcomeText           types.String     `tfsdk:"welcome_text"`
+	CollapseLoginForm     types.Bool       `tfsdk:"collapse_login_form"`
+	TwoFactorMode         types.String     `tfsdk:"two_factor_mode"`
+	TwoFactorGracePeriod  types.Int64      `tfsdk:"two_factor_grace_period"`
 	PerProjectPermissions types.Bool       `tfsdk:"per_project_permissions"`
 	EmailVerification     types.Bool       `tfsdk:"email_verification"`
 	Modules               authModulesModel `tfsdk:"modules"`
@@ -47,6 +55,11 @@ type authModulesModel struct {
 	GithubEnterprise *authModuleGithubModel    `tfsdk:"github_enterprise"`
 	LDAP             *authModuleLDAPModel      `tfsdk:"ldap"`
 	Space            *authModuleSpaceModel     `tfsdk:"jetbrains_space"`
+	WindowsDomain    *authModuleWindowsModel   `tfsdk:"windows_domain"`
+	NTLM             *authModuleNTLMModel      `tfsdk:"ntlm"`
+	HTTPHeader       *authModuleHeaderModel    `tfsdk:"http_header"`
+	Gitlab           *authModuleGitlabModel    `tfsdk:"gitlab"`
+	Bitbucket        *authModuleBitbucketModel `tfsdk:"bitbucket"`
 }
 
 func (r *authResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -76,12 +89,22 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"collapse_login_form": schema.BoolAttribute{
 				Required: true,
 			},
-			//"two_factor_mode": schema.StringAttribute{
-			//	Required: true,
-			//	Validators: []validator.String{
-			//		stringvalidator.OneOf([]string{"DISABLED", "OPTIONAL", "MANDATORY"}...),
-			//	},
-			//},
+			"two_factor_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Two-factor authentication mode: `DISABLED`, `OPTIONAL` or `MANDATORY`. The current server value is kept when not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"DISABLED", "OPTIONAL", "MANDATORY"}...),
+				},
+			},
+			"two_factor_grace_period": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in hours users have to set up two-factor authentication once it is `MANDATORY`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"per_project_permissions": schema.BoolAttribute{
 				Required: true,
 			},
@@ -117,6 +140,7 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"create_new_users": schema.BoolAttribute{
 								Required: true,
 							},
+							"default_groups": defaultGroupsAttribute(),
 							"all_domains": schema.BoolAttribute{
 								Required: true,
 							},
@@ -131,6 +155,7 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"create_new_users": schema.BoolAttribute{
 								Required: true,
 							},
+							"default_groups": defaultGroupsAttribute(),
 							"organizations": schema.StringAttribute{
 								Required: true,
 							},
@@ -142,6 +167,7 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"create_new_users": schema.BoolAttribute{
 								Required: true,
 							},
+							"default_groups": defaultGroupsAttribute(),
 							"organizations": schema.StringAttribute{
 								Required: true,
 							},
@@ -153,6 +179,7 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"create_new_users": schema.BoolAttribute{
 								Required: true,
 							},
+							"default_groups": defaultGroupsAttribute(),
 							"organizations": schema.StringAttribute{
 								Required: true,
 							},
@@ -172,6 +199,70 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"create_new_users": schema.BoolAttribute{
 								Required: true,
 							},
+							"default_groups": defaultGroupsAttribute(),
+						},
+					},
+					"windows_domain": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Authentication with Windows domain credentials on the login page.",
+						Attributes: map[string]schema.Attribute{
+							"create_new_users": schema.BoolAttribute{
+								Required: true,
+							},
+							"default_domain": schema.StringAttribute{
+								Optional:    true,
+								Description: "Domain used when the username is specified without one.",
+							},
+						},
+					},
+					"ntlm": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Windows domain authentication with NTLM or Kerberos via HTTP.",
+						Attributes: map[string]schema.Attribute{
+							"create_new_users": schema.BoolAttribute{
+								Required: true,
+							},
+						},
+					},
+					"http_header": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Authentication with a username passed in an HTTP header by an SSO proxy.",
+						Attributes: map[string]schema.Attribute{
+							"header_name": schema.StringAttribute{
+								Required:    true,
+								Description: "Name of the HTTP header with the username.",
+							},
+							"create_new_users": schema.BoolAttribute{
+								Required: true,
+							},
+						},
+					},
+					"gitlab": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Authentication using a GitLab.com account.",
+						Attributes: map[string]schema.Attribute{
+							"create_new_users": schema.BoolAttribute{
+								Required: true,
+							},
+							"groups": schema.StringAttribute{
+								Optional:    true,
+								Description: "Restrict authentication to members of the specified GitLab groups, comma-separated.",
+							},
+							"default_groups": defaultGroupsAttribute(),
+						},
+					},
+					"bitbucket": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Authentication using a Bitbucket Cloud account.",
+						Attributes: map[string]schema.Attribute{
+							"create_new_users": schema.BoolAttribute{
+								Required: true,
+							},
+							"workspaces": schema.StringAttribute{
+								Optional:    true,
+								Description: "Restrict authentication to members of the specified Bitbucket workspaces, comma-separated.",
+							},
+							"default_groups": defaultGroupsAttribute(),
 						},
 					},
 				},
@@ -180,6 +271,17 @@ func (r *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func defaultGroupsAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Description: "Keys of the groups new users are added to on the first login.",
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+}
+
 func (r *authResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config authResourceModel
 	diags := req.Config.Get(ctx, &config)
@@ -228,6 +330,13 @@ func (r *authResource) ValidateConfig(ctx context.Context, req resource.Validate
 		}
 	}
 
+	if !config.TwoFactorGracePeriod.IsNull() && !config.TwoFactorMode.IsUnknown() && config.TwoFactorMode.ValueString() != "MANDATORY" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("two_factor_grace_period"),
+			"'two_factor_grace_period' can only be specified if 'two_factor_mode' is 'MANDATORY'",
+			"",
+		)
+	}
 }
 
 func (r *authResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -313,14 +422,30 @@ func (r *authResource) ImportState(ctx context.Context, req resource.ImportState
 
 func (r *authResource) update(plan authResourceModel) (authResourceModel, error) {
 	settings := client.AuthSettings{
-		AllowGuest:        plan.AllowGuest.ValueBool(),
-		GuestUsername:     plan.GuestUsername.ValueString(),
-		WelcomeText:       plan.WelcomeText.ValueString(),
-		CollapseLoginForm: plan.CollapseLoginForm.ValueBool(),
-		//TwoFactorMode:         plan.TwoFactorMode.ValueString(),
+		AllowGuest:            plan.AllowGuest.ValueBool(),
+		GuestUsername:         plan.GuestUsername.ValueString(),
+		WelcomeText:           plan.WelcomeText.ValueString(),
+		CollapseLoginForm:     plan.CollapseLoginForm.ValueBool(),
+		TwoFactorMode:         plan.TwoFactorMode.ValueString(),
 		PerProjectPermissions: plan.PerProjectPermissions.ValueBool(),
 		EmailVerification:     plan.EmailVerification.ValueBool(),
 	}
+	if !plan.TwoFactorGracePeriod.IsNull() && !plan.TwoFactorGracePeriod.IsUnknown() {
+		period := plan.TwoFactorGracePeriod.ValueInt64()
+		settings.TwoFactorGracePeriod = &period
+	}
+
+	// Keep the current two-factor settings if they are not managed
+	if plan.TwoFactorMode.IsUnknown() || plan.TwoFactorMode.IsNull() {
+		current, err := r.client.GetAuthSettings()
+		if err != nil {
+			return authResourceModel{}, err
+		}
+		settings.TwoFactorMode = current.TwoFactorMode
+		if settings.TwoFactorGracePeriod == nil {
+			settings.TwoFactorGracePeriod = current.TwoFactorGracePeriod
+		}
+	}
 
 	if plan.Modules.Token != nil {
 		settings.Modules.Module = append(settings.Modules.Module, client.Module{Name: "Token-Auth"})
@@ -384,6 +509,46 @@ func (r *authResource) update(plan authResourceModel) (authResourceModel, error)
 			},
 		})
 	}
+	if plan.Modules.WindowsDomain != nil {
+		settings.Modules.Module = append(settings.Modules.Module, client.Module{
+			Name: "NT-Domain",
+			Properties: &models.Properties{
+				Property: plan.Modules.WindowsDomain.getProperties(),
+			},
+		})
+	}
+	if plan.Modules.NTLM != nil {
+		settings.Modules.Module = append(settings.Modules.Module, client.Module{
+			Name: "NTLM-HTTP",
+			Properties: &models.Properties{
+				Property: plan.Modules.NTLM.getProperties(),
+			},
+		})
+	}
+	if plan.Modules.HTTPHeader != nil {
+		settings.Modules.Module = append(settings.Modules.Module, client.Module{
+			Name: "HTTP-Header",
+			Properties: &models.Properties{
+				Property: plan.Modules.HTTPHeader.getProperties(),
+			},
+		})
+	}
+	if plan.Modules.Gitlab != nil {
+		settings.Modules.Module = append(settings.Modules.Module, client.Module{
+			Name: "GitLabCom-oauth",
+			Properties: &models.Properties{
+				Property: plan.Modules.Gitlab.getProperties(),
+			},
+		})
+	}
+	if plan.Modules.Bitbucket != nil {
+		settings.Modules.Module = append(settings.Modules.Module, client.Module{
+			Name: "BitBucketCloud-oauth",
+			Properties: &models.Properties{
+				Property: plan.Modules.Bitbucket.getProperties(),
+			},
+		})
+	}
 
 	result, err := r.client.SetAuthSettings(settings)
 	if err != nil {
@@ -400,14 +565,20 @@ func (r *authResource) readState(result client.AuthSettings) (authResourceModel,
 	state.GuestUsername = types.StringValue(result.GuestUsername)
 	state.WelcomeText = types.StringValue(result.WelcomeText)
 	state.CollapseLoginForm = types.BoolValue(result.CollapseLoginForm)
-	//state.TwoFactorMode = types.StringValue(result.TwoFactorMode)
+	state.TwoFactorMode = types.StringValue(result.TwoFactorMode)
+	state.TwoFactorGracePeriod = types.Int64Null()
+	if result.TwoFactorGracePeriod != nil {
+		state.TwoFactorGracePeriod = types.Int64Value(*result.TwoFactorGracePeriod)
+	}
 	state.PerProjectPermissions = types.BoolValue(result.PerProjectPermissions)
 	state.EmailVerification = types.BoolValue(result.EmailVerification)
 
 	for _, module := range result.Modules.Module {
 		props := make(map[string]string)
-		for _, p := range module.Properties.Property {
-			props[p.Name] = p.Value
+		if module.Properties != nil {
+			for _, p := range module.Properties.Property {
+				props[p.Name] = p.Value
+			}
 		}
 
 		if module.Name == "Token-Auth" {
@@ -482,6 +653,51 @@ func (r *authResource) readState(result client.AuthSettings) (authResourceModel,
 			}
 			continue
 		}
+
+		if module.Name == "NT-Domain" {
+			state.Modules.WindowsDomain = &authModuleWindowsModel{}
+			err := state.Modules.WindowsDomain.setFields(props)
+			if err != nil {
+				return authResourceModel{}, err
+			}
+			continue
+		}
+
+		if module.Name == "NTLM-HTTP" {
+			state.Modules.NTLM = &authModuleNTLMModel{}
+			err := state.Modules.NTLM.setFields(props)
+			if err != nil {
+				return authResourceModel{}, err
+			}
+			continue
+		}
+
+		if module.Name == "HTTP-Header" {
+			state.Modules.HTTPHeader = &authModuleHeaderModel{}
+			err := state.Modules.HTTPHeader.setFields(props)
+			if err != nil {
+				return authResourceModel{}, err
+			}
+			continue
+		}
+
+		if module.Name == "GitLabCom-oauth" {
+			state.Modules.Gitlab = &authModuleGitlabModel{}
+			err := state.Modules.Gitlab.setFields(props)
+			if err != nil {
+				return authResourceModel{}, err
+			}
+			continue
+		}
+
+		if module.Name == "BitBucketCloud-oauth" {
+			state.Modules.Bitbucket = &authModuleBitbucketModel{}
+			err := state.Modules.Bitbucket.setFields(props)
+			if err != nil {
+				return authResourceModel{}, err
+			}
+			continue
+		}
 	}
 
 	return state, nil
@@ -503,16 +719,19 @@ type authModuleGoogleModel struct {
 	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
 	AllDomains     types.Bool   `tfsdk:"all_domains"`
 	Domains        types.String `tfsdk:"domains"`
+	DefaultGroups  types.Set    `tfsdk:"default_groups"`
 }
 
 type authModuleGithubModel struct {
 	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
 	Organizations  types.String `tfsdk:"organizations"`
+	DefaultGroups  types.Set    `tfsdk:"default_groups"`
 }
 
 type authModuleGithubAppModel struct {
 	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
 	Organizations  types.String `tfsdk:"organizations"`
+	DefaultGroups  types.Set    `tfsdk:"default_groups"`
 }
 
 type authModuleLDAPModel struct {
@@ -521,6 +740,33 @@ type authModuleLDAPModel struct {
 
 type authModuleSpaceModel struct {
 	CreateNewUsers types.Bool `tfsdk:"create_new_users"`
+	DefaultGroups  types.Set  `tfsdk:"default_groups"`
+}
+
+type authModuleWindowsModel struct {
+	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
+	DefaultDomain  types.String `tfsdk:"default_domain"`
+}
+
+type authModuleNTLMModel struct {
+	CreateNewUsers types.Bool `tfsdk:"create_new_users"`
+}
+
+type authModuleHeaderModel struct {
+	HeaderName     types.String `tfsdk:"header_name"`
+	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
+}
+
+type authModuleGitlabModel struct {
+	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
+	Groups         types.String `tfsdk:"groups"`
+	DefaultGroups  types.Set    `tfsdk:"default_groups"`
+}
+
+type authModuleBitbucketModel struct {
+	CreateNewUsers types.Bool   `tfsdk:"create_new_users"`
+	Workspaces     types.String `tfsdk:"workspaces"`
+	DefaultGroups  types.Set    `tfsdk:"default_groups"`
 }
 
 func (m *authModuleBuiltInModel) getProperties() []models.Property {
@@ -575,7 +821,7 @@ func (m *authModuleGoogleModel) getProperties() []models.Property {
 		})
 	}
 
-	return props
+	return appendDefaultGroups(props, m.DefaultGroups)
 }
 
 func (m *authModuleGoogleModel) setFields(props map[string]string) error {
@@ -594,15 +840,16 @@ func (m *authModuleGoogleModel) setFields(props map[string]string) error {
 	if all == false {
 		m.Domains = types.StringValue(props["domains"])
 	}
+	m.DefaultGroups = readDefaultGroups(props)
 
 	return nil
 }
 
 func (m *authModuleGithubAppModel) getProperties() []models.Property {
-	return []models.Property{
+	return appendDefaultGroups([]models.Property{
 		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
 		{Name: "organisation", Value: m.Organizations.ValueString()},
-	}
+	}, m.DefaultGroups)
 }
 
 func (m *authModuleGithubAppModel) setFields(props map[string]string) error {
@@ -613,14 +860,15 @@ func (m *authModuleGithubAppModel) setFields(props map[string]string) error {
 
 	m.CreateNewUsers = types.BoolValue(creating)
 	m.Organizations = types.StringValue(props["organisation"])
+	m.DefaultGroups = readDefaultGroups(props)
 	return nil
 }
 
 func (m *authModuleGithubModel) getProperties() []models.Property {
-	return []models.Property{
+	return appendDefaultGroups([]models.Property{
 		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
 		{Name: "organization", Value: m.Organizations.ValueString()},
-	}
+	}, m.DefaultGroups)
 }
 
 func (m *authModuleGithubModel) setFields(props map[string]string) error {
@@ -631,6 +879,7 @@ func (m *authModuleGithubModel) setFields(props map[string]string) error {
 
 	m.CreateNewUsers = types.BoolValue(creating)
 	m.Organizations = types.StringValue(props["organization"])
+	m.DefaultGroups = readDefaultGroups(props)
 	return nil
 }
 
@@ -651,12 +900,57 @@ func (m *authModuleLDAPModel) setFields(props map[string]string) error {
 }
 
 func (m *authModuleSpaceModel) getProperties() []models.Property {
+	return appendDefaultGroups([]models.Property{
+		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
+	}, m.DefaultGroups)
+}
+
+func (m *authModuleSpaceModel) setFields(props map[string]string) error {
+	creating, err := strconv.ParseBool(props["allowCreatingNewUsersByLogin"])
+	if err != nil {
+		return err
+	}
+
+	m.CreateNewUsers = types.BoolValue(creating)
+	m.DefaultGroups = readDefaultGroups(props)
+	return nil
+}
+
+func (m *authModuleWindowsModel) getProperties() []models.Property {
+	props := []models.Property{
+		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
+	}
+
+	if m.DefaultDomain.IsNull() != true {
+		props = append(props, models.Property{
+			Name:  "defaultDomain",
+			Value: m.DefaultDomain.ValueString(),
+		})
+	}
+
+	return props
+}
+
+func (m *authModuleWindowsModel) setFields(props map[string]string) error {
+	creating, err := strconv.ParseBool(props["allowCreatingNewUsersByLogin"])
+	if err != nil {
+		return err
+	}
+
+	m.CreateNewUsers = types.BoolValue(creating)
+	if domain, ok := props["defaultDomain"]; ok && domain != "" {
+		m.DefaultDomain = types.StringValue(domain)
+	}
+	return nil
+}
+
+func (m *authModuleNTLMModel) getProperties() []models.Property {
 	return []models.Property{
 		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
 	}
 }
 
-func (m *authModuleSpaceModel) setFields(props map[string]string) error {
+func (m *authModuleNTLMModel) setFields(props map[string]string) error {
 	creating, err := strconv.ParseBool(props["allowCreatingNewUsersByLogin"])
 	if err != nil {
 		return err
@@ -665,3 +959,109 @@ func (m *authModuleSpaceModel) setFields(props map[string]string) error {
 	m.CreateNewUsers = types.BoolValue(creating)
 	return nil
 }
+
+func (m *authModuleHeaderModel) getProperties() []models.Property {
+	return []models.Property{
+		{Name: "headerName", Value: m.HeaderName.ValueString()},
+		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
+	}
+}
+
+func (m *authModuleHeaderModel) setFields(props map[string]string) error {
+	creating, err := strconv.ParseBool(props["allowCreatingNewUsersByLogin"])
+	if err != nil {
+		return err
+	}
+
+	m.CreateNewUsers = types.BoolValue(creating)
+	m.HeaderName = types.StringValue(props["headerName"])
+	return nil
+}
+
+func (m *authModuleGitlabModel) getProperties() []models.Property {
+	props := []models.Property{
+		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
+	}
+
+	if m.Groups.IsNull() != true {
+		props = append(props, models.Property{
+			Name:  "groups",
+			Value: m.Groups.ValueString(),
+		})
+	}
+
+	return appendDefaultGroups(props, m.DefaultGroups)
+}
+
+func (m *authModuleGitlabModel) setFields(props map[string]string) error {
+	creating, err := strconv.ParseBool(props["allowCreatingNewUsersByLogin"])
+	if err != nil {
+		return err
+	}
+
+	m.CreateNewUsers = types.BoolValue(creating)
+	if groups, ok := props["groups"]; ok && groups != "" {
+		m.Groups = types.StringValue(groups)
+	}
+	m.DefaultGroups = readDefaultGroups(props)
+	return nil
+}
+
+func (m *authModuleBitbucketModel) getProperties() []models.Property {
+	props := []models.Property{
+		{Name: "allowCreatingNewUsersByLogin", Value: strconv.FormatBool(m.CreateNewUsers.ValueBool())},
+	}
+
+	if m.Workspaces.IsNull() != true {
+		props = append(props, models.Property{
+			Name:  "workspaces",
+			Value: m.Workspaces.ValueString(),
+		})
+	}
+
+	return appendDefaultGroups(props, m.DefaultGroups)
+}
+
+func (m *authModuleBitbucketModel) setFields(props map[string]string) error {
+	creating, err := strconv.ParseBool(props["allowCreatingNewUsersByLogin"])
+	if err != nil {
+		return err
+	}
+
+	m.CreateNewUsers = types.BoolValue(creating)
+	if workspaces, ok := props["workspaces"]; ok && workspaces != "" {
+		m.Workspaces = types.StringValue(workspaces)
+	}
+	m.DefaultGroups = readDefaultGroups(props)
+	return nil
+}
+
+// appendDefaultGroups adds the groups new users are added to, comma-separated
+func appendDefaultGroups(props []models.Property, groups types.Set) []models.Property {
+	if groups.IsNull() || groups.IsUnknown() {
+		return props
+	}
+
+	var keys []string
+	for _, g := range groups.Elements() {
+		keys = append(keys, g.(types.String).ValueString())
+	}
+	sort.Strings(keys)
+
+	return append(props, models.Property{
+		Name:  "defaultUserGroups",
+		Value: strings.Join(keys, ","),
+	})
+}
+
+func readDefaultGroups(props map[string]string) types.Set {
+	if props["defaultUserGroups"] == "" {
+		return types.SetNull(types.StringType)
+	}
+
+	var groups []attr.Value
+	for _, key := range strings.Split(props["defaultUserGroups"], ",") {
+		groups = append(groups, types.StringValue(strings.TrimSpace(key)))
+	}
+	return types.SetValueMust(types.StringType, groups)
+}
@@ -16,7 +16,7 @@ resource "teamcity_auth_settings" "test" {
   guest_username      = "guest"
   welcome_text        = ""
   collapse_login_form = false
-  # two_factor_mode     = "OPTIONAL"
+  two_factor_mode     = "OPTIONAL"
   per_project_permissions = false
   email_verification  = false
 
@@ -28,6 +28,51 @@ resource "teamcity_auth_settings" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "allow_guest", "false"),
+					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "two_factor_mode", "OPTIONAL"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_group" "new_users" {
+  name = "auth_new_users"
+}
+
+resource "teamcity_auth_settings" "test" {
+  allow_guest             = false
+  guest_username          = "guest"
+  welcome_text            = ""
+  collapse_login_form     = false
+  two_factor_mode         = "MANDATORY"
+  two_factor_grace_period = 24
+  per_project_permissions = true
+  email_verification      = false
+
+  modules = {
+    token = {}
+    built_in = {
+      registration     = false
+      change_passwords = false
+    }
+
+    gitlab = {
+      create_new_users = true
+      groups           = "my-company"
+      default_groups   = [teamcity_group.new_users.id]
+    }
+
+    http_header = {
+      header_name      = "X-Forwarded-User"
+      create_new_users = false
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "two_factor_mode", "MANDATORY"),
+					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "two_factor_grace_period", "24"),
+					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "modules.gitlab.groups", "my-company"),
+					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "modules.gitlab.default_groups.#", "1"),
+					resource.TestCheckResourceAttr("teamcity_auth_settings.test", "modules.http_header.header_name", "X-Forwarded-User"),
 				),
 			},
 		},
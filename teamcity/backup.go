@@ -0,0 +1,294 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &backupResource{}
+	_ resource.ResourceWithConfigure      = &backupResource{}
+	_ resource.ResourceWithValidateConfig = &backupResource{}
+)
+
+const defaultBackupTimeout = 30 * time.Minute
+
+// backupPollInterval is the delay between two reads of the backup status.
+var backupPollInterval = 5 * time.Second
+
+// backupUnchangedPolls is the number of reads after which a status equal to
+// the one before the backup was started is taken as the result.
+var backupUnchangedPolls = 6
+
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+type backupResource struct {
+	client *client.Client
+}
+
+type backupResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	FileName                 types.String `tfsdk:"file_name"`
+	AddTimestamp             types.Bool   `tfsdk:"add_timestamp"`
+	IncludeConfigs           types.Bool   `tfsdk:"include_configs"`
+	IncludeDatabase          types.Bool   `tfsdk:"include_database"`
+	IncludeBuildLogs         types.Bool   `tfsdk:"include_build_logs"`
+	IncludePersonalChanges   types.Bool   `tfsdk:"include_personal_changes"`
+	IncludeRunningBuilds     types.Bool   `tfsdk:"include_running_builds"`
+	IncludeSupplementaryData types.Bool   `tfsdk:"include_supplementary_data"`
+	Triggers                 types.Map    `tfsdk:"triggers"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (r *backupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (r *backupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	include := func(description string, value bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(value),
+			Description: fmt.Sprintf("%s Defaults to `%t`.", description, value),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Runs a [server backup](https://www.jetbrains.com/help/teamcity/creating-backup-from-teamcity-web-ui.html) and waits for it to finish. " +
+			"A new backup is made on create and whenever an argument or a value of `triggers` changes. Destroying the resource keeps the backup file on the server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the backup file in the backup directory of the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the backup file, the extension `.zip` is added by the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"add_timestamp":              include("Add the current time to the file name.", true),
+			"include_configs":            include("Include the server settings, projects and build configurations, plugins.", true),
+			"include_database":           include("Include the database.", true),
+			"include_build_logs":         include("Include the build logs.", false),
+			"include_personal_changes":   include("Include the personal changes.", false),
+			"include_running_builds":     include("Include the data of the running builds.", false),
+			"include_supplementary_data": include("Include the supplementary data of the plugins.", false),
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values, changing any of them makes a new backup, e.g. a hash of the settings applied after the backup.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the backup, e.g. `30m` or `2h`. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (r *backupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *backupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid timeout",
+			"The timeout must be a positive duration, e.g. 30m or 2h.",
+		)
+	}
+}
+
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultBackupTimeout
+	if !plan.Timeout.IsNull() {
+		d, err := time.ParseDuration(plan.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid timeout", err.Error())
+			return
+		}
+		timeout = d
+	}
+
+	// Only one backup can run at a time
+	prior := r.waitForIdle(ctx, timeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	file, err := r.client.StartBackup(models.BackupOptions{
+		FileName:                 plan.FileName.ValueString(),
+		AddTimestamp:             plan.AddTimestamp.ValueBool(),
+		IncludeConfigs:           plan.IncludeConfigs.ValueBool(),
+		IncludeDatabase:          plan.IncludeDatabase.ValueBool(),
+		IncludeBuildLogs:         plan.IncludeBuildLogs.ValueBool(),
+		IncludePersonalChanges:   plan.IncludePersonalChanges.ValueBool(),
+		IncludeRunningBuilds:     plan.IncludeRunningBuilds.ValueBool(),
+		IncludeSupplementaryData: plan.IncludeSupplementaryData.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error starting backup",
+			err.Error(),
+		)
+		return
+	}
+
+	r.waitForBackup(ctx, timeout, prior, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(file)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state, a backup is a one-time operation.
+func (r *backupResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update only changes the timeout, all other arguments make a new backup.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete keeps the backup file on the server.
+func (r *backupResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// waitForIdle polls the status until no backup is running and returns the
+// last status, i.e. the result of the previous backup.
+func (r *backupResource) waitForIdle(ctx context.Context, timeout time.Duration, diag *diag.Diagnostics) string {
+	return r.pollBackup(ctx, timeout, diag, func(status string) bool {
+		return !strings.EqualFold(status, "running")
+	})
+}
+
+// waitForBackup polls the status until the started backup is done. The server
+// keeps the status of the previous backup until the next one runs, and the
+// REST API reports no backup history or timestamp, so a status equal to prior
+// only counts once Running has been seen. When the status stays at prior for
+// backupUnchangedPolls reads, the backup finished before the first read or
+// never ran, which is reported as a warning.
+func (r *backupResource) waitForBackup(ctx context.Context, timeout time.Duration, prior string, diag *diag.Diagnostics) {
+	running := false
+	unchanged := 0
+	status := r.pollBackup(ctx, timeout, diag, func(status string) bool {
+		if strings.EqualFold(status, "running") {
+			running = true
+			return false
+		}
+		if running || !strings.EqualFold(status, prior) {
+			return true
+		}
+		unchanged++
+		return unchanged >= backupUnchangedPolls
+	})
+	if diag.HasError() {
+		return
+	}
+
+	if !running && strings.EqualFold(status, prior) {
+		diag.AddWarning(
+			"Backup result not confirmed",
+			"The backup status stayed '"+status+"' as before the backup was started, check the backup history on the server.",
+		)
+		return
+	}
+
+	switch strings.ToLower(status) {
+	case "faulted", "cancelled":
+		diag.AddError(
+			"Backup failed",
+			"The server reported the backup status '"+status+"', see the backup history on the server for details.",
+		)
+	}
+}
+
+// pollBackup reads the backup status until done returns true and returns the
+// last status.
+func (r *backupResource) pollBackup(ctx context.Context, timeout time.Duration, diag *diag.Diagnostics, done func(status string) bool) string {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := r.client.GetBackupStatus()
+		if err != nil {
+			diag.AddError(
+				"Error reading backup status",
+				err.Error(),
+			)
+			return ""
+		}
+		if done(status) {
+			return status
+		}
+
+		if time.Now().Add(backupPollInterval).After(deadline) {
+			diag.AddError(
+				"Timeout waiting for backup",
+				fmt.Sprintf("The backup did not finish within %s, last status: %s", timeout, status),
+			)
+			return status
+		}
+		select {
+		case <-ctx.Done():
+			diag.AddError("Backup interrupted", ctx.Err().Error())
+			return status
+		case <-time.After(backupPollInterval):
+		}
+	}
+}
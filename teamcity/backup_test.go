@@ -0,0 +1,152 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"terraform-provider-teamcity/client"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_backup" "test" {
+	file_name = "acceptance"
+	include_database = false
+	triggers = {
+		run = "1"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("teamcity_backup.test", "id"),
+					resource.TestCheckResourceAttr("teamcity_backup.test", "include_configs", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestWaitForBackup(t *testing.T) {
+	backupPollInterval = 10 * time.Millisecond
+	defer func() { backupPollInterval = 5 * time.Second }()
+
+	serve := func(statuses ...string) *httptest.Server {
+		var calls atomic.Int32
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			i := min(int(calls.Add(1))-1, len(statuses)-1)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(statuses[i]))
+		}))
+	}
+	wait := func(server *httptest.Server, timeout time.Duration, prior string) diag.Diagnostics {
+		c := client.NewClient(server.URL, "token", "", "", 0)
+		r := backupResource{client: &c}
+		var diags diag.Diagnostics
+		r.waitForBackup(context.Background(), timeout, prior, &diags)
+		return diags
+	}
+	waitForIdle := func(server *httptest.Server) (string, diag.Diagnostics) {
+		c := client.NewClient(server.URL, "token", "", "", 0)
+		r := backupResource{client: &c}
+		var diags diag.Diagnostics
+		status := r.waitForIdle(context.Background(), time.Second, &diags)
+		return status, diags
+	}
+
+	t.Run("finished", func(t *testing.T) {
+		server := serve("Running", "Running", "Finished")
+		defer server.Close()
+
+		if diags := wait(server, time.Second, "Finished"); diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("faulted", func(t *testing.T) {
+		server := serve("Running", "Faulted")
+		defer server.Close()
+
+		diags := wait(server, time.Second, "Idle")
+		if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Backup failed" {
+			t.Fatalf("expected a failure, got: %v", diags)
+		}
+	})
+
+	t.Run("fast finish", func(t *testing.T) {
+		server := serve("Finished")
+		defer server.Close()
+
+		if diags := wait(server, time.Second, "Idle"); diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("not started yet", func(t *testing.T) {
+		server := serve("Idle", "Running", "Faulted")
+		defer server.Close()
+
+		diags := wait(server, time.Second, "Idle")
+		if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Backup failed" {
+			t.Fatalf("expected a failure, got: %v", diags)
+		}
+	})
+
+	t.Run("stale faulted", func(t *testing.T) {
+		server := serve("Faulted", "Faulted", "Running", "Finished")
+		defer server.Close()
+
+		if diags := wait(server, time.Second, "Faulted"); diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("status unchanged", func(t *testing.T) {
+		server := serve("Faulted")
+		defer server.Close()
+
+		diags := wait(server, time.Second, "Faulted")
+		if diags.HasError() || diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Backup result not confirmed" {
+			t.Fatalf("expected a warning, got: %v", diags)
+		}
+	})
+
+	t.Run("previous backup faulted", func(t *testing.T) {
+		server := serve("Faulted")
+		defer server.Close()
+
+		status, diags := waitForIdle(server)
+		if diags.HasError() || status != "Faulted" {
+			t.Fatalf("unexpected result %q: %v", status, diags)
+		}
+	})
+
+	t.Run("previous backup running", func(t *testing.T) {
+		server := serve("Running", "Cancelled")
+		defer server.Close()
+
+		status, diags := waitForIdle(server)
+		if diags.HasError() || status != "Cancelled" {
+			t.Fatalf("unexpected result %q: %v", status, diags)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		server := serve("Running")
+		defer server.Close()
+
+		diags := wait(server, 50*time.Millisecond, "Idle")
+		if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Timeout waiting for backup" {
+			t.Fatalf("expected a timeout, got: %v", diags)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package teamcity
 
 import (
 	"context"
+	"strings"
 	"terraform-provider-teamcity/client"
 	"terraform-provider-teamcity/models"
 
@@ -23,6 +24,49 @@ type buildConfDataSource struct {
 	client *client.Client
 }
 
+type buildConfDataSourceModel struct {
+	ID                   types.String                 `tfsdk:"id"`
+	Name                 types.String                 `tfsdk:"name"`
+	ProjectID            types.String                 `tfsdk:"project_id"`
+	Description          types.String                 `tfsdk:"description"`
+	BuildType            types.String                 `tfsdk:"build_type"`
+	Paused               types.Bool                   `tfsdk:"paused"`
+	Templates            []types.String               `tfsdk:"templates"`
+	Parameters           map[string]types.String      `tfsdk:"parameters"`
+	Steps                []buildConfStepModel         `tfsdk:"steps"`
+	Triggers             []buildConfSettingModel      `tfsdk:"triggers"`
+	Features             []buildConfSettingModel      `tfsdk:"features"`
+	AgentRequirements    []buildConfSettingModel      `tfsdk:"agent_requirements"`
+	SnapshotDependencies []buildConfDependencyModel   `tfsdk:"snapshot_dependencies"`
+	ArtifactDependencies []buildConfDependencyModel   `tfsdk:"artifact_dependencies"`
+	VcsRoots             []buildConfVcsRootEntryModel `tfsdk:"vcs_roots"`
+}
+
+type buildConfStepModel struct {
+	ID         types.String            `tfsdk:"id"`
+	Name       types.String            `tfsdk:"name"`
+	Type       types.String            `tfsdk:"type"`
+	Properties map[string]types.String `tfsdk:"properties"`
+}
+
+// buildConfSettingModel is shared by triggers, features and agent requirements
+type buildConfSettingModel struct {
+	ID         types.String            `tfsdk:"id"`
+	Type       types.String            `tfsdk:"type"`
+	Properties map[string]types.String `tfsdk:"properties"`
+}
+
+type buildConfDependencyModel struct {
+	ID          types.String            `tfsdk:"id"`
+	DependsOnId types.String            `tfsdk:"depends_on_id"`
+	Properties  map[string]types.String `tfsdk:"properties"`
+}
+
+type buildConfVcsRootEntryModel struct {
+	VcsRootId     types.String `tfsdk:"vcs_root_id"`
+	CheckoutRules types.String `tfsdk:"checkout_rules"`
+}
+
 func (d *buildConfDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -56,19 +100,95 @@ func (d *buildConfDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 			"paused": schema.BoolAttribute{
 				Computed: true,
 			},
+			"templates": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the templates attached to the build configuration.",
+			},
+			"parameters": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Parameters of the build configuration, including inherited ones. Password parameters are not included.",
+			},
+			"steps": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Build steps in the execution order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.StringAttribute{Computed: true},
+						"name":       schema.StringAttribute{Computed: true},
+						"type":       schema.StringAttribute{Computed: true},
+						"properties": buildConfPropertiesAttribute(),
+					},
+				},
+			},
+			"triggers":           buildConfSettingsAttribute("Build triggers."),
+			"features":           buildConfSettingsAttribute("Build features, including failure conditions."),
+			"agent_requirements": buildConfSettingsAttribute("Agent requirements, the type is the requirement condition."),
+			"snapshot_dependencies": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Snapshot dependencies.",
+				NestedObject: buildConfDependencyObject(),
+			},
+			"artifact_dependencies": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Artifact dependencies.",
+				NestedObject: buildConfDependencyObject(),
+			},
+			"vcs_roots": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "VCS roots attached to the build configuration.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vcs_root_id":    schema.StringAttribute{Computed: true},
+						"checkout_rules": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildConfPropertiesAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+}
+
+func buildConfSettingsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:    true,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id":         schema.StringAttribute{Computed: true},
+				"type":       schema.StringAttribute{Computed: true},
+				"properties": buildConfPropertiesAttribute(),
+			},
+		},
+	}
+}
+
+func buildConfDependencyObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"depends_on_id": schema.StringAttribute{Computed: true},
+			"properties":    buildConfPropertiesAttribute(),
 		},
 	}
 }
 
 func (d *buildConfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var conf models.BuildTypeDataModel
+	var conf buildConfDataSourceModel
 	diags := req.Config.Get(ctx, &conf)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := d.client.GetBuildType(conf.ID.ValueString())
+	result, err := d.client.GetBuildTypeDetails(conf.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading build configuration",
@@ -96,6 +216,114 @@ func (d *buildConfDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 	conf.Paused = types.BoolValue(result.Paused)
 
+	conf.Templates = []types.String{}
+	if result.Templates != nil {
+		for _, t := range result.Templates.BuildType {
+			conf.Templates = append(conf.Templates, types.StringValue(t.ID))
+		}
+	}
+
+	conf.Parameters = map[string]types.String{}
+	if result.Parameters != nil {
+		for _, p := range result.Parameters.Property {
+			if p.Type != nil && strings.HasPrefix(p.Type.RawValue, models.ParamTypePassword) {
+				continue
+			}
+			conf.Parameters[p.Name] = types.StringValue(p.Value)
+		}
+	}
+
+	conf.Steps = []buildConfStepModel{}
+	if result.Steps != nil {
+		for _, st := range result.Steps.Step {
+			conf.Steps = append(conf.Steps, buildConfStepModel{
+				ID:         types.StringValue(st.ID),
+				Name:       types.StringValue(st.Name),
+				Type:       types.StringValue(st.Type),
+				Properties: buildConfProperties(st.Properties),
+			})
+		}
+	}
+
+	conf.Triggers = []buildConfSettingModel{}
+	if result.Triggers != nil {
+		for _, t := range result.Triggers.Trigger {
+			conf.Triggers = append(conf.Triggers, buildConfSetting(t.ID, t.Type, t.Properties))
+		}
+	}
+
+	conf.Features = []buildConfSettingModel{}
+	if result.Features != nil {
+		for _, f := range result.Features.Feature {
+			conf.Features = append(conf.Features, buildConfSetting(f.ID, f.Type, f.Properties))
+		}
+	}
+
+	conf.AgentRequirements = []buildConfSettingModel{}
+	if result.AgentRequirements != nil {
+		for _, ar := range result.AgentRequirements.AgentRequirement {
+			conf.AgentRequirements = append(conf.AgentRequirements, buildConfSetting(ar.ID, ar.Type, ar.Properties))
+		}
+	}
+
+	conf.SnapshotDependencies = []buildConfDependencyModel{}
+	if result.SnapshotDependencies != nil {
+		for _, dep := range result.SnapshotDependencies.SnapshotDependency {
+			conf.SnapshotDependencies = append(conf.SnapshotDependencies, buildConfDependency(dep.ID, dep.SourceBuildType, dep.Properties))
+		}
+	}
+
+	conf.ArtifactDependencies = []buildConfDependencyModel{}
+	if result.ArtifactDependencies != nil {
+		for _, dep := range result.ArtifactDependencies.ArtifactDependency {
+			conf.ArtifactDependencies = append(conf.ArtifactDependencies, buildConfDependency(dep.ID, dep.SourceBuildType, dep.Properties))
+		}
+	}
+
+	conf.VcsRoots = []buildConfVcsRootEntryModel{}
+	if result.VcsRootEntries != nil {
+		for _, e := range result.VcsRootEntries.VcsRootEntry {
+			entry := buildConfVcsRootEntryModel{
+				VcsRootId:     types.StringValue(e.ID),
+				CheckoutRules: types.StringValue(e.CheckoutRules),
+			}
+			if e.VcsRoot != nil && e.VcsRoot.ID != nil {
+				entry.VcsRootId = types.StringValue(*e.VcsRoot.ID)
+			}
+			conf.VcsRoots = append(conf.VcsRoots, entry)
+		}
+	}
+
 	diags = resp.State.Set(ctx, &conf)
 	resp.Diagnostics.Append(diags...)
 }
+
+func buildConfProperties(props *models.Properties) map[string]types.String {
+	result := map[string]types.String{}
+	if props != nil {
+		for _, p := range props.Property {
+			result[p.Name] = types.StringValue(p.Value)
+		}
+	}
+	return result
+}
+
+func buildConfSetting(id, settingType string, props *models.Properties) buildConfSettingModel {
+	return buildConfSettingModel{
+		ID:         types.StringValue(id),
+		Type:       types.StringValue(settingType),
+		Properties: buildConfProperties(props),
+	}
+}
+
+func buildConfDependency(id string, source *models.SourceBuildTypeJson, props *models.Properties) buildConfDependencyModel {
+	dep := buildConfDependencyModel{
+		ID:          types.StringValue(id),
+		DependsOnId: types.StringNull(),
+		Properties:  buildConfProperties(props),
+	}
+	if source != nil {
+		dep.DependsOnId = types.StringValue(source.ID)
+	}
+	return dep
+}
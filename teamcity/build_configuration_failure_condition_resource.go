@@ -0,0 +1,457 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &bcFailureConditionResource{}
+	_ resource.ResourceWithConfigure      = &bcFailureConditionResource{}
+	_ resource.ResourceWithImportState    = &bcFailureConditionResource{}
+	_ resource.ResourceWithValidateConfig = &bcFailureConditionResource{}
+)
+
+// failureMetrics maps metric names to the TeamCity metric keys
+var failureMetrics = map[string]string{
+	"build_duration":      "BuildDurationNetTime",
+	"artifacts_size":      "VisibleArtifactsSize",
+	"test_count":          "TotalTestCount",
+	"failed_test_count":   "FailedTestCount",
+	"ignored_test_count":  "IgnoredTestCount",
+	"inspection_errors":   "InspectionStatsE",
+	"inspection_warnings": "InspectionStatsW",
+	"duplicates":          "DuplicatorStats",
+	"line_coverage":       "CodeCoverageL",
+	"method_coverage":     "CodeCoverageM",
+	"class_coverage":      "CodeCoverageC",
+}
+
+// failureAnchors maps compare_to values to the TeamCity anchor builds
+var failureAnchors = map[string]string{
+	"last_successful": "lastSuccessful",
+	"last_pinned":     "lastPinned",
+	"last_finished":   "lastFinished",
+	"build_with_tag":  "buildTag",
+}
+
+func NewBuildConfigurationFailureConditionResource() resource.Resource {
+	return &bcFailureConditionResource{}
+}
+
+type bcFailureConditionResource struct {
+	client *client.Client
+}
+
+func (r *bcFailureConditionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build_configuration_failure_condition"
+}
+
+func (r *bcFailureConditionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var metrics []string
+	for name := range failureMetrics {
+		metrics = append(metrics, name)
+	}
+	sort.Strings(metrics)
+
+	resp.Schema = schema.Schema{
+		Description: "A failure condition in a TeamCity build configuration. Basic conditions like the execution timeout are managed by `teamcity_build_configuration_settings`. More info [here](https://www.jetbrains.com/help/teamcity/build-failure-conditions.html)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource identifier (Failure condition ID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"build_configuration_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the build configuration to which this failure condition belongs.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metric_change": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Fail the build on a metric change.",
+				PlanModifiers: []planmodifier.Object{
+					blockTypeRequiresReplace("failure condition"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"metric": schema.StringAttribute{
+						Required:    true,
+						Description: "Values: `" + strings.Join(metrics, "`, `") + "`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(metrics...),
+						},
+					},
+					"threshold": schema.Int64Attribute{
+						Required:    true,
+						Description: "Threshold value of the metric, or of its change when compared to another build.",
+					},
+					"units": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("absolute"),
+						Description: "Values: `absolute`, `percent`. Default `absolute`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"absolute", "percent"}...),
+						},
+					},
+					"comparison": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("more"),
+						Description: "Fail if the metric is `more` or `less` than the threshold. Default `more`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"more", "less"}...),
+						},
+					},
+					"compare_to": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("constant"),
+						Description: "Values: `constant`, `last_successful`, `last_pinned`, `last_finished`, `build_with_tag`. Default `constant`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"constant", "last_successful", "last_pinned", "last_finished", "build_with_tag"}...),
+						},
+					},
+					"build_tag": schema.StringAttribute{
+						Optional:    true,
+						Description: "Tag of the build to compare to, required for `build_with_tag`.",
+					},
+					"stop_build": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Stop the build as soon as the condition is met.",
+					},
+				},
+			},
+			"build_log_pattern": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Fail the build on a specific text in the build log.",
+				PlanModifiers: []planmodifier.Object{
+					blockTypeRequiresReplace("failure condition"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"pattern": schema.StringAttribute{
+						Required: true,
+					},
+					"pattern_type": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("contains"),
+						Description: "Values: `contains`, `regexp`. Default `contains`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"contains", "regexp"}...),
+						},
+					},
+					"fail_on": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("match"),
+						Description: "Fail if the build log has a `match` or has `no_match`. Default `match`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"match", "no_match"}...),
+						},
+					},
+					"failure_message": schema.StringAttribute{
+						Optional:    true,
+						Description: "Build problem description shown when the condition is met.",
+					},
+					"stop_build": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Stop the build as soon as the condition is met.",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRoot("metric_change"),
+						path.MatchRoot("build_log_pattern"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *bcFailureConditionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.FailureConditionDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if m := config.MetricChange; m != nil && !m.CompareTo.IsUnknown() {
+		if m.CompareTo.ValueString() == "build_with_tag" && m.BuildTag.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("metric_change").AtName("build_tag"),
+				"'build_tag' must be specified when comparing to 'build_with_tag'",
+				"",
+			)
+		}
+		if m.CompareTo.ValueString() != "build_with_tag" && !m.BuildTag.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("metric_change").AtName("build_tag"),
+				"'build_tag' can only be specified when comparing to 'build_with_tag'",
+				"",
+			)
+		}
+	}
+}
+
+func (r *bcFailureConditionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *bcFailureConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FailureConditionDataModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildTypeId := plan.BuildConfigurationId.ValueString()
+
+	actual, err := r.client.NewBuildTypeFeature(buildTypeId, failureConditionFeature(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating failure condition", err.Error())
+		return
+	}
+
+	newState, err := readFailureCondition(*actual, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading failure condition", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *bcFailureConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FailureConditionDataModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildTypeId := state.BuildConfigurationId.ValueString()
+	idParts := strings.Split(state.ID.ValueString(), "/")
+	conditionId := idParts[len(idParts)-1]
+
+	actual, err := r.client.GetBuildTypeFeature(buildTypeId, conditionId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading failure condition", err.Error())
+		return
+	}
+
+	if actual == nil || (actual.Type != models.FailureOnMetricType && actual.Type != models.FailureOnMessageType) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, err := readFailureCondition(*actual, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading failure condition", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *bcFailureConditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.FailureConditionDataModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FailureConditionDataModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildTypeId := plan.BuildConfigurationId.ValueString()
+	idParts := strings.Split(state.ID.ValueString(), "/")
+	conditionId := idParts[len(idParts)-1]
+
+	feature := failureConditionFeature(plan)
+	feature.ID = conditionId
+
+	actual, err := r.client.UpdateBuildTypeFeature(buildTypeId, conditionId, feature)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating failure condition", err.Error())
+		return
+	}
+
+	newState, err := readFailureCondition(*actual, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading failure condition", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *bcFailureConditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.FailureConditionDataModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buildTypeId := state.BuildConfigurationId.ValueString()
+	idParts := strings.Split(state.ID.ValueString(), "/")
+	conditionId := idParts[len(idParts)-1]
+
+	err := r.client.DeleteBuildTypeFeature(buildTypeId, conditionId)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting failure condition", err.Error())
+		return
+	}
+}
+
+func (r *bcFailureConditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: build_configuration_id/failure_condition_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("build_configuration_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func failureConditionFeature(plan models.FailureConditionDataModel) models.BuildFeatureJson {
+	var feature models.BuildFeatureJson
+	props := []models.Property{}
+
+	if m := plan.MetricChange; m != nil {
+		feature.Type = models.FailureOnMetricType
+		props = appendProp(props, "metricKey", types.StringValue(failureMetrics[m.Metric.ValueString()]))
+		props = appendProp(props, "metricThreshold", types.StringValue(strconv.FormatInt(m.Threshold.ValueInt64(), 10)))
+		if m.Units.ValueString() == "percent" {
+			props = appendProp(props, "metricUnits", types.StringValue("metricUnitsPercents"))
+		} else {
+			props = appendProp(props, "metricUnits", types.StringValue("metricUnitsDefault"))
+		}
+		props = appendProp(props, "moreOrLess", m.Comparison)
+		if anchor, ok := failureAnchors[m.CompareTo.ValueString()]; ok {
+			props = appendProp(props, "withBuildAnchor", types.StringValue("true"))
+			props = appendProp(props, "anchorBuild", types.StringValue(anchor))
+			props = appendProp(props, "buildTag", m.BuildTag)
+		} else {
+			props = appendProp(props, "withBuildAnchor", types.StringValue("false"))
+		}
+		props = appendProp(props, "stopBuildOnFailure", types.StringValue(strconv.FormatBool(m.StopBuild.ValueBool())))
+	}
+
+	if p := plan.BuildLogPattern; p != nil {
+		feature.Type = models.FailureOnMessageType
+		props = appendProp(props, "buildFailureOnMessage.messagePattern", p.Pattern)
+		props = appendProp(props, "buildFailureOnMessage.conditionType", p.PatternType)
+		props = appendProp(props, "buildFailureOnMessage.reverse", types.StringValue(strconv.FormatBool(p.FailOn.ValueString() == "no_match")))
+		props = appendProp(props, "buildFailureOnMessage.outputText", p.FailureMessage)
+		props = appendProp(props, "stopBuildOnFailure", types.StringValue(strconv.FormatBool(p.StopBuild.ValueBool())))
+	}
+
+	feature.Properties = &models.Properties{Property: props}
+	return feature
+}
+
+func readFailureCondition(actual models.BuildFeatureJson, plan models.FailureConditionDataModel) (models.FailureConditionDataModel, error) {
+	props := make(map[string]string)
+	if actual.Properties != nil {
+		for _, p := range actual.Properties.Property {
+			props[p.Name] = p.Value
+		}
+	}
+
+	var state models.FailureConditionDataModel
+	state.BuildConfigurationId = plan.BuildConfigurationId
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.BuildConfigurationId.ValueString(), actual.ID))
+
+	switch actual.Type {
+	case models.FailureOnMetricType:
+		m := &models.MetricChangeModel{
+			Metric:     types.StringValue(props["metricKey"]),
+			Units:      types.StringValue("absolute"),
+			Comparison: types.StringValue(props["moreOrLess"]),
+			CompareTo:  types.StringValue("constant"),
+			BuildTag:   propString(props, "buildTag"),
+			StopBuild:  types.BoolValue(props["stopBuildOnFailure"] == "true"),
+		}
+		for name, key := range failureMetrics {
+			if key == props["metricKey"] {
+				m.Metric = types.StringValue(name)
+			}
+		}
+		threshold, err := strconv.ParseInt(props["metricThreshold"], 10, 64)
+		if err != nil {
+			return state, fmt.Errorf("unexpected metric threshold %q: %w", props["metricThreshold"], err)
+		}
+		m.Threshold = types.Int64Value(threshold)
+		if props["metricUnits"] == "metricUnitsPercents" {
+			m.Units = types.StringValue("percent")
+		}
+		if props["withBuildAnchor"] == "true" {
+			for name, anchor := range failureAnchors {
+				if anchor == props["anchorBuild"] {
+					m.CompareTo = types.StringValue(name)
+				}
+			}
+		}
+		state.MetricChange = m
+	case models.FailureOnMessageType:
+		state.BuildLogPattern = &models.BuildLogPatternModel{
+			Pattern:        types.StringValue(props["buildFailureOnMessage.messagePattern"]),
+			PatternType:    types.StringValue(props["buildFailureOnMessage.conditionType"]),
+			FailOn:         types.StringValue("match"),
+			FailureMessage: propString(props, "buildFailureOnMessage.outputText"),
+			StopBuild:      types.BoolValue(props["stopBuildOnFailure"] == "true"),
+		}
+		if props["buildFailureOnMessage.reverse"] == "true" {
+			state.BuildLogPattern.FailOn = types.StringValue("no_match")
+		}
+	default:
+		return state, fmt.Errorf("build feature %s is not a failure condition, got type %q", actual.ID, actual.Type)
+	}
+
+	return state, nil
+}
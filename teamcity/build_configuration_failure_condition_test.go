@@ -0,0 +1,108 @@
+package teamcity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBuildConfigurationFailureCondition_basic(t *testing.T) {
+	projectName := "TestProjectFailureCondition"
+	buildConfName := "TestBuildConfFailureCondition"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+                    resource "teamcity_project" "test" {
+                        name = "%s"
+                    }
+
+                    resource "teamcity_build_configuration" "test" {
+                        name       = "%s"
+                        project_id = teamcity_project.test.id
+                    }
+
+                    resource "teamcity_build_configuration_failure_condition" "artifacts" {
+                        build_configuration_id = teamcity_build_configuration.test.id
+                        metric_change = {
+                            metric     = "artifacts_size"
+                            threshold  = 20
+                            units      = "percent"
+                            compare_to = "last_successful"
+                        }
+                    }
+
+                    resource "teamcity_build_configuration_failure_condition" "log" {
+                        build_configuration_id = teamcity_build_configuration.test.id
+                        build_log_pattern = {
+                            pattern         = "FATAL"
+                            failure_message = "Fatal error in the build log"
+                            stop_build      = true
+                        }
+                    }
+                `, projectName, buildConfName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("teamcity_build_configuration_failure_condition.artifacts", "id"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.metric", "artifacts_size"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.threshold", "20"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.units", "percent"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.comparison", "more"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.compare_to", "last_successful"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.pattern", "FATAL"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.pattern_type", "contains"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.fail_on", "match"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.stop_build", "true"),
+				),
+			},
+			{
+				ResourceName:      "teamcity_build_configuration_failure_condition.artifacts",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+                    resource "teamcity_project" "test" {
+                        name = "%s"
+                    }
+
+                    resource "teamcity_build_configuration" "test" {
+                        name       = "%s"
+                        project_id = teamcity_project.test.id
+                    }
+
+                    resource "teamcity_build_configuration_failure_condition" "artifacts" {
+                        build_configuration_id = teamcity_build_configuration.test.id
+                        metric_change = {
+                            metric     = "test_count"
+                            threshold  = 10
+                            comparison = "less"
+                            compare_to = "build_with_tag"
+                            build_tag  = "release"
+                        }
+                    }
+
+                    resource "teamcity_build_configuration_failure_condition" "log" {
+                        build_configuration_id = teamcity_build_configuration.test.id
+                        build_log_pattern = {
+                            pattern      = "BUILD (SUCCESS|OK)"
+                            pattern_type = "regexp"
+                            fail_on      = "no_match"
+                        }
+                    }
+                `, projectName, buildConfName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.metric", "test_count"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.units", "absolute"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.comparison", "less"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.artifacts", "metric_change.build_tag", "release"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.pattern_type", "regexp"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.fail_on", "no_match"),
+					resource.TestCheckNoResourceAttr("teamcity_build_configuration_failure_condition.log", "build_log_pattern.failure_message"),
+				),
+			},
+		},
+	})
+}
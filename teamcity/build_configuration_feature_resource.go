@@ -7,6 +7,7 @@ import (
 	"terraform-provider-teamcity/client"
 	"terraform-provider-teamcity/models"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,9 +17,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &bcFeatureResource{}
-	_ resource.ResourceWithConfigure   = &bcFeatureResource{}
-	_ resource.ResourceWithImportState = &bcFeatureResource{}
+	_ resource.Resource                   = &bcFeatureResource{}
+	_ resource.ResourceWithConfigure      = &bcFeatureResource{}
+	_ resource.ResourceWithImportState    = &bcFeatureResource{}
+	_ resource.ResourceWithValidateConfig = &bcFeatureResource{}
 )
 
 func NewBuildConfigurationFeatureResource() resource.Resource {
@@ -34,34 +36,127 @@ func (r *bcFeatureResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 func (r *bcFeatureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "A build feature in a TeamCity build configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:    true,
-				Description: "Resource identifier (Feature ID).",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"build_configuration_id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of the build configuration to which this feature belongs.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"type": schema.StringAttribute{
-				Required:    true,
-				Description: "The type of the build feature (e.g., swabra, freeDiskSpace, xml-report-plugin).",
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Resource identifier (Feature ID).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"properties": schema.MapAttribute{
-				Optional:    true,
-				Computed:    true,
-				ElementType: types.StringType,
-				Description: "Properties for the build feature.",
+		},
+		"build_configuration_id": schema.StringAttribute{
+			Required:    true,
+			Description: "ID of the build configuration to which this feature belongs.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The type of the build feature (e.g., swabra, freeDiskSpace, xml-report-plugin). Required unless one of the typed blocks is used.",
+		},
+		"properties": schema.MapAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Properties for the build feature. Computed from the server when one of the typed blocks is used.",
+		},
+	}
+	for name, attribute := range typedFeatureAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "A build feature in a TeamCity build configuration. Generic features are configured with `type` and `properties`, the most used ones are also available as typed blocks.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *bcFeatureResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.BuildFeatureDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blocks := typedFeatureBlocksSet(config)
+	if len(blocks) > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting build feature blocks",
+			fmt.Sprintf("Only one typed build feature block can be specified, got: %s", strings.Join(blocks, ", ")),
+		)
+		return
+	}
+
+	if len(blocks) == 0 {
+		if config.Type.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Missing build feature type",
+				"Either 'type' or one of the typed blocks ("+strings.Join(typedFeatureBlocks, ", ")+") must be specified",
+			)
+		}
+		return
+	}
+
+	featureType := typedFeatureType(config)
+	if !config.Type.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() != featureType {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Conflicting build feature type",
+			fmt.Sprintf("'%s' block defines a feature of type '%s', got '%s'", blocks[0], featureType, config.Type.ValueString()),
+		)
+	}
+	if !config.Properties.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("properties"),
+			"'properties' cannot be specified together with a typed block",
+			"",
+		)
+	}
+
+	if p := config.CommitStatusPublisher; p != nil {
+		validateHostingAuth(path.Root("commit_status_publisher"), p.Publisher, p.ServerUrl, p.AuthType, p.Username, p.AccessToken, p.TokenId, &resp.Diagnostics)
+	}
+	if p := config.PullRequests; p != nil {
+		validateHostingAuth(path.Root("pull_requests"), p.Provider, p.ServerUrl, p.AuthType, p.Username, p.AccessToken, p.TokenId, &resp.Diagnostics)
+		if !p.FilterAuthorRole.IsNull() && !p.Provider.IsUnknown() && p.Provider.ValueString() != "github" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pull_requests").AtName("filter_author_role"),
+				"'filter_author_role' can only be specified for the 'github' provider",
+				"",
+			)
+		}
+	}
+}
+
+func validateHostingAuth(root path.Path, hosting, serverUrl, authType, username, accessToken, tokenId types.String, diags *diag.Diagnostics) {
+	if hosting.IsUnknown() || authType.IsUnknown() {
+		return
+	}
+
+	if hosting.ValueString() == "bitbucket_cloud" && !serverUrl.IsNull() {
+		diags.AddAttributeError(root.AtName("server_url"), "'server_url' cannot be specified for 'bitbucket_cloud'", "")
+	}
+	if hosting.ValueString() != "bitbucket_cloud" && !username.IsNull() {
+		diags.AddAttributeError(root.AtName("username"), "'username' can only be specified for 'bitbucket_cloud'", "")
+	}
+
+	switch authType.ValueString() {
+	case "stored_token":
+		if tokenId.IsNull() {
+			diags.AddAttributeError(root.AtName("token_id"), "'token_id' must be specified for the 'stored_token' auth type", "")
+		}
+	case "vcs_root":
+	default:
+		if accessToken.IsNull() {
+			diags.AddAttributeError(root.AtName("access_token"), "'access_token' must be specified for the 'token' auth type", "")
+		}
+		if hosting.ValueString() == "bitbucket_cloud" && username.IsNull() {
+			diags.AddAttributeError(root.AtName("username"), "'username' must be specified for 'bitbucket_cloud' with the 'token' auth type", "")
+		}
 	}
 }
 
@@ -89,7 +184,10 @@ func (r *bcFeatureResource) Create(ctx context.Context, req resource.CreateReque
 		feature.ID = plan.ID.ValueString()
 	}
 
-	if !plan.Properties.IsNull() {
+	if featureType := typedFeatureType(plan); featureType != "" {
+		feature.Type = featureType
+		feature.Properties = &models.Properties{Property: typedFeatureProperties(plan)}
+	} else if !plan.Properties.IsNull() && !plan.Properties.IsUnknown() {
 		propsMap := make(map[string]string)
 		diags = plan.Properties.ElementsAs(ctx, &propsMap, false)
 		resp.Diagnostics.Append(diags...)
@@ -114,6 +212,7 @@ func (r *bcFeatureResource) Create(ctx context.Context, req resource.CreateReque
 	plan.Type = types.StringValue(actual.Type)
 
 	plan.Properties = mergePropertiesFromServer(actual.Properties, plan.Properties, &resp.Diagnostics)
+	plan = readTypedFeature(actual.Type, actual.Properties, plan)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -142,6 +241,8 @@ func (r *bcFeatureResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The typed blocks are picked from the prior type, it is null after an import
+	state = readTypedFeature(actual.Type, actual.Properties, state)
 	state.ID = types.StringValue(fmt.Sprintf("%s/%s", buildTypeId, actual.ID))
 	state.Type = types.StringValue(actual.Type)
 
@@ -175,7 +276,10 @@ func (r *bcFeatureResource) Update(ctx context.Context, req resource.UpdateReque
 		Type: plan.Type.ValueString(),
 	}
 
-	if !plan.Properties.IsNull() {
+	if featureType := typedFeatureType(plan); featureType != "" {
+		feature.Type = featureType
+		feature.Properties = &models.Properties{Property: typedFeatureProperties(plan)}
+	} else if !plan.Properties.IsNull() && !plan.Properties.IsUnknown() {
 		propsMap := make(map[string]string)
 		diags = plan.Properties.ElementsAs(ctx, &propsMap, false)
 		resp.Diagnostics.Append(diags...)
@@ -200,6 +304,7 @@ func (r *bcFeatureResource) Update(ctx context.Context, req resource.UpdateReque
 	plan.Type = types.StringValue(actual.Type)
 
 	plan.Properties = mergePropertiesFromServer(actual.Properties, plan.Properties, &resp.Diagnostics)
+	plan = readTypedFeature(actual.Type, actual.Properties, plan)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
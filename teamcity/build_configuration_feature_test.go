@@ -118,3 +118,74 @@ func TestAccBuildConfigurationFeature_emptyPropertiesNoDrift(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBuildConfigurationFeature_typed(t *testing.T) {
+	projectName := "TestProjectFeatureTyped"
+	buildConfName := "TestBuildConfFeatureTyped"
+
+	cfg := providerConfig + fmt.Sprintf(`
+        resource "teamcity_project" "test" {
+            name = "%s"
+        }
+        resource "teamcity_build_configuration" "test" {
+            name       = "%s"
+            project_id = teamcity_project.test.id
+        }
+        resource "teamcity_build_configuration_feature" "perfmon" {
+            build_configuration_id = teamcity_build_configuration.test.id
+            perfmon                = {}
+        }
+        resource "teamcity_build_configuration_feature" "xml_report" {
+            build_configuration_id = teamcity_build_configuration.test.id
+            xml_report = {
+                report_type = "junit"
+                rules       = ["+:build/test-results/**/*.xml"]
+            }
+        }
+        resource "teamcity_build_configuration_feature" "pull_requests" {
+            build_configuration_id = teamcity_build_configuration.test.id
+            pull_requests = {
+                provider             = "github"
+                access_token         = "token"
+                filter_target_branch = "+:main"
+                filter_author_role   = "MEMBER"
+            }
+        }
+    `, projectName, buildConfName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.perfmon", "type", "perfmon"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.xml_report", "type", "xml-report-plugin"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.xml_report", "properties.xmlReportParsing.reportType", "junit"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.xml_report", "xml_report.verbose", "false"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.pull_requests", "type", "pullRequests"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.pull_requests", "properties.providerType", "github"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_feature.pull_requests", "pull_requests.auth_type", "token"),
+				),
+			},
+			{
+				Config: cfg,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			// Import testing, the typed block is read from the feature type
+			{
+				ResourceName:      "teamcity_build_configuration_feature.xml_report",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "teamcity_build_configuration_feature.pull_requests",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pull_requests.access_token"},
+			},
+		},
+	})
+}
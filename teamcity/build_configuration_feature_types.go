@@ -0,0 +1,539 @@
+package teamcity
+
+import (
+	"strings"
+	"terraform-provider-teamcity/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Build feature types backed by the typed blocks of teamcity_build_configuration_feature.
+const (
+	commitStatusPublisherFeature = "commit-status-publisher"
+	pullRequestsFeature          = "pullRequests"
+	dockerSupportFeature         = "DockerSupport"
+	perfmonFeature               = "perfmon"
+	xmlReportFeature             = "xml-report-plugin"
+	sshAgentFeature              = "ssh-agent"
+)
+
+// hostingKeys holds the property names a code hosting integration uses in
+// the commit status publisher and pull requests features.
+type hostingKeys struct {
+	Id          string
+	ServerUrl   string
+	AuthType    string
+	Username    string
+	AccessToken string
+	TokenAuth   string
+}
+
+var commitStatusPublishers = map[string]hostingKeys{
+	"github": {
+		Id:          "githubStatusPublisher",
+		ServerUrl:   "github_host",
+		AuthType:    "github_authType",
+		AccessToken: "secure:github_access_token",
+		TokenAuth:   "token",
+	},
+	"gitlab": {
+		Id:          "gitlabStatusPublisher",
+		ServerUrl:   "gitlabApiUrl",
+		AuthType:    "authType",
+		AccessToken: "secure:gitlabAccessToken",
+		TokenAuth:   "token",
+	},
+	"bitbucket_cloud": {
+		Id:          "bitbucketCloudPublisher",
+		AuthType:    "authType",
+		Username:    "bitbucketUsername",
+		AccessToken: "secure:bitbucketPassword",
+		TokenAuth:   "password",
+	},
+}
+
+var pullRequestProviders = map[string]hostingKeys{
+	"github": {
+		Id:          "github",
+		ServerUrl:   "serverUrl",
+		AuthType:    "authenticationType",
+		AccessToken: "secure:accessToken",
+		TokenAuth:   "token",
+	},
+	"gitlab": {
+		Id:          "gitlab",
+		ServerUrl:   "serverUrl",
+		AuthType:    "authenticationType",
+		AccessToken: "secure:accessToken",
+		TokenAuth:   "token",
+	},
+	"bitbucket_cloud": {
+		Id:          "bitbucketCloud",
+		AuthType:    "authenticationType",
+		Username:    "username",
+		AccessToken: "secure:password",
+		TokenAuth:   "password",
+	},
+}
+
+var hostingNames = []string{"github", "gitlab", "bitbucket_cloud"}
+
+var authTypes = []string{"token", "stored_token", "vcs_root"}
+
+// typedFeatureBlocks lists the typed blocks, only one of them can be set at a time.
+var typedFeatureBlocks = []string{
+	"commit_status_publisher",
+	"pull_requests",
+	"docker_support",
+	"perfmon",
+	"xml_report",
+	"ssh_agent",
+}
+
+func typedFeatureAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"commit_status_publisher": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Typed `commit-status-publisher` feature, reports build statuses to the code hosting.",
+			Attributes: map[string]schema.Attribute{
+				"publisher": schema.StringAttribute{
+					Required:    true,
+					Description: "Values: `github`, `gitlab`, `bitbucket_cloud`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(hostingNames...),
+					},
+				},
+				"vcs_root_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "Publish statuses only for the changes of this VCS root. All attached VCS roots are used if not set.",
+				},
+				"server_url": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "API URL of the code hosting, e.g. `https://api.github.com`.",
+				},
+				"auth_type": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("token"),
+					Description: "Values: `token`, `stored_token`, `vcs_root`. Default `token`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(authTypes...),
+					},
+				},
+				"username": schema.StringAttribute{
+					Optional:    true,
+					Description: "Username for the `bitbucket_cloud` publisher.",
+				},
+				"access_token": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Access token (app password for `bitbucket_cloud`) used with the `token` auth type.",
+				},
+				"token_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "ID of the token stored in a `teamcity_connection`, used with the `stored_token` auth type.",
+				},
+			},
+		},
+		"pull_requests": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Typed `pullRequests` feature, makes pull request branches available to the build configuration.",
+			Attributes: map[string]schema.Attribute{
+				"provider": schema.StringAttribute{
+					Required:    true,
+					Description: "Values: `github`, `gitlab`, `bitbucket_cloud`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(hostingNames...),
+					},
+				},
+				"vcs_root_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "Monitor pull requests only for this VCS root. All attached VCS roots are used if not set.",
+				},
+				"server_url": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "API URL of the code hosting, e.g. `https://api.github.com`.",
+				},
+				"auth_type": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("token"),
+					Description: "Values: `token`, `stored_token`, `vcs_root`. Default `token`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(authTypes...),
+					},
+				},
+				"username": schema.StringAttribute{
+					Optional:    true,
+					Description: "Username for the `bitbucket_cloud` provider.",
+				},
+				"access_token": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Access token (app password for `bitbucket_cloud`) used with the `token` auth type.",
+				},
+				"token_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "ID of the token stored in a `teamcity_connection`, used with the `stored_token` auth type.",
+				},
+				"filter_source_branch": schema.StringAttribute{
+					Optional:    true,
+					Description: "Branch filter applied to the source branches of pull requests.",
+				},
+				"filter_target_branch": schema.StringAttribute{
+					Optional:    true,
+					Description: "Branch filter applied to the target branches of pull requests.",
+				},
+				"filter_author_role": schema.StringAttribute{
+					Optional:    true,
+					Description: "Values: `MEMBER`, `MEMBER_OR_COLLABORATOR`, `EVERYBODY`. Only for the `github` provider.",
+					Validators: []validator.String{
+						stringvalidator.OneOf([]string{"MEMBER", "MEMBER_OR_COLLABORATOR", "EVERYBODY"}...),
+					},
+				},
+				"ignore_drafts": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Ignore draft pull requests.",
+				},
+			},
+		},
+		"docker_support": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Typed `DockerSupport` feature.",
+			Attributes: map[string]schema.Attribute{
+				"cleanup_pushed": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Remove images pushed by the build when the build is cleaned up.",
+				},
+				"login_to_registry": schema.ListAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Feature IDs of Docker registry `teamcity_connection` resources to log in to before the build.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+			},
+		},
+		"perfmon": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Typed `perfmon` feature, collects agent performance statistics. Has no settings, use `perfmon = {}`.",
+			Attributes:  map[string]schema.Attribute{},
+		},
+		"xml_report": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Typed `xml-report-plugin` feature, imports test and inspection reports.",
+			Attributes: map[string]schema.Attribute{
+				"report_type": schema.StringAttribute{
+					Required:    true,
+					Description: "Report format, e.g. `junit`, `nunit`, `surefire`, `testng`, `checkstyle`.",
+				},
+				"rules": schema.ListAttribute{
+					Required:    true,
+					ElementType: types.StringType,
+					Description: "Monitoring rules (report paths), e.g. `+:build/test-results/**/*.xml`.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"verbose": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Enable verbose output to the build log.",
+				},
+			},
+		},
+		"ssh_agent": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Typed `ssh-agent` feature, loads an uploaded SSH key to the agent during the build.",
+			Attributes: map[string]schema.Attribute{
+				"ssh_key": schema.StringAttribute{
+					Required:    true,
+					Description: "Name of the `teamcity_ssh_key` uploaded to the project.",
+				},
+				"passphrase": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+// typedFeatureType returns the TeamCity feature type of the typed block set in the model, or an empty string.
+func typedFeatureType(m models.BuildFeatureDataModel) string {
+	switch {
+	case m.CommitStatusPublisher != nil:
+		return commitStatusPublisherFeature
+	case m.PullRequests != nil:
+		return pullRequestsFeature
+	case m.DockerSupport != nil:
+		return dockerSupportFeature
+	case m.Perfmon != nil:
+		return perfmonFeature
+	case m.XmlReport != nil:
+		return xmlReportFeature
+	case m.SshAgent != nil:
+		return sshAgentFeature
+	}
+	return ""
+}
+
+// typedFeatureBlocksSet returns the names of the typed blocks set in the model.
+func typedFeatureBlocksSet(m models.BuildFeatureDataModel) []string {
+	set := []bool{
+		m.CommitStatusPublisher != nil,
+		m.PullRequests != nil,
+		m.DockerSupport != nil,
+		m.Perfmon != nil,
+		m.XmlReport != nil,
+		m.SshAgent != nil,
+	}
+
+	var blocks []string
+	for i, ok := range set {
+		if ok {
+			blocks = append(blocks, typedFeatureBlocks[i])
+		}
+	}
+	return blocks
+}
+
+// typedFeatureProperties converts the typed block set in the model to feature properties.
+func typedFeatureProperties(m models.BuildFeatureDataModel) []models.Property {
+	props := []models.Property{}
+
+	switch {
+	case m.CommitStatusPublisher != nil:
+		p := m.CommitStatusPublisher
+		keys := commitStatusPublishers[p.Publisher.ValueString()]
+		props = appendProp(props, "publisherId", types.StringValue(keys.Id))
+		props = appendProp(props, "vcsRootId", p.VcsRootId)
+		props = appendHostingProps(props, keys, p.ServerUrl, p.AuthType, p.Username, p.AccessToken, p.TokenId)
+	case m.PullRequests != nil:
+		p := m.PullRequests
+		keys := pullRequestProviders[p.Provider.ValueString()]
+		props = appendProp(props, "providerType", types.StringValue(keys.Id))
+		props = appendProp(props, "vcsRootId", p.VcsRootId)
+		props = appendHostingProps(props, keys, p.ServerUrl, p.AuthType, p.Username, p.AccessToken, p.TokenId)
+		props = appendProp(props, "filterSourceBranch", p.FilterSourceBranch)
+		props = appendProp(props, "filterTargetBranch", p.FilterTargetBranch)
+		props = appendProp(props, "filterAuthorRole", p.FilterAuthorRole)
+		if p.IgnoreDrafts.ValueBool() {
+			props = appendProp(props, "ignoreDrafts", types.StringValue("true"))
+		}
+	case m.DockerSupport != nil:
+		p := m.DockerSupport
+		if p.CleanupPushed.ValueBool() {
+			props = appendProp(props, "cleanupPushed", types.StringValue("true"))
+		}
+		if len(p.LoginToRegistry) > 0 {
+			props = appendProp(props, "loginToRegistry", types.StringValue(joinStrings(p.LoginToRegistry, ",")))
+		}
+	case m.XmlReport != nil:
+		p := m.XmlReport
+		props = appendProp(props, "xmlReportParsing.reportType", p.ReportType)
+		props = appendProp(props, "xmlReportParsing.reportDirs", types.StringValue(joinStrings(p.Rules, "\n")))
+		if p.Verbose.ValueBool() {
+			props = appendProp(props, "xmlReportParsing.verboseOutput", types.StringValue("true"))
+		}
+	case m.SshAgent != nil:
+		p := m.SshAgent
+		props = appendProp(props, "teamcitySshKey", p.SshKey)
+		props = appendProp(props, "secure:passphrase", p.Passphrase)
+	}
+
+	return props
+}
+
+// readTypedFeature refreshes the typed blocks of the prior model from the server feature.
+// The block is picked from the feature type, so that imported features get their block.
+// Features managed with `type` and `properties` keep the typed blocks empty. Secure values
+// are never returned by the server and are kept from the prior model.
+func readTypedFeature(featureType string, actual *models.Properties, prior models.BuildFeatureDataModel) models.BuildFeatureDataModel {
+	props := make(map[string]string)
+	if actual != nil {
+		for _, p := range actual.Property {
+			props[p.Name] = p.Value
+		}
+	}
+
+	state := prior
+	state.CommitStatusPublisher = nil
+	state.PullRequests = nil
+	state.DockerSupport = nil
+	state.Perfmon = nil
+	state.XmlReport = nil
+	state.SshAgent = nil
+
+	if !prior.Type.IsNull() && typedFeatureType(prior) == "" {
+		return state
+	}
+
+	switch featureType {
+	case commitStatusPublisherFeature:
+		var priorPublisher, accessToken types.String
+		if prior.CommitStatusPublisher != nil {
+			priorPublisher = prior.CommitStatusPublisher.Publisher
+			accessToken = prior.CommitStatusPublisher.AccessToken
+		}
+		publisher := hostingName(commitStatusPublishers, props["publisherId"], priorPublisher)
+		keys := commitStatusPublishers[publisher]
+		state.CommitStatusPublisher = &models.CommitStatusPublisherModel{
+			Publisher:   types.StringValue(publisher),
+			VcsRootId:   propString(props, "vcsRootId"),
+			AccessToken: accessToken,
+		}
+		p := state.CommitStatusPublisher
+		p.ServerUrl, p.AuthType, p.Username, p.TokenId = readHostingProps(props, keys)
+
+	case pullRequestsFeature:
+		var priorProvider, accessToken types.String
+		if prior.PullRequests != nil {
+			priorProvider = prior.PullRequests.Provider
+			accessToken = prior.PullRequests.AccessToken
+		}
+		provider := hostingName(pullRequestProviders, props["providerType"], priorProvider)
+		keys := pullRequestProviders[provider]
+		state.PullRequests = &models.PullRequestsModel{
+			Provider:           types.StringValue(provider),
+			VcsRootId:          propString(props, "vcsRootId"),
+			AccessToken:        accessToken,
+			FilterSourceBranch: propString(props, "filterSourceBranch"),
+			FilterTargetBranch: propString(props, "filterTargetBranch"),
+			FilterAuthorRole:   propString(props, "filterAuthorRole"),
+			IgnoreDrafts:       types.BoolValue(props["ignoreDrafts"] == "true"),
+		}
+		p := state.PullRequests
+		p.ServerUrl, p.AuthType, p.Username, p.TokenId = readHostingProps(props, keys)
+
+	case dockerSupportFeature:
+		state.DockerSupport = &models.DockerSupportModel{
+			CleanupPushed:   types.BoolValue(props["cleanupPushed"] == "true"),
+			LoginToRegistry: splitStrings(props["loginToRegistry"], ","),
+		}
+
+	case perfmonFeature:
+		state.Perfmon = &models.PerfmonModel{}
+
+	case xmlReportFeature:
+		state.XmlReport = &models.XmlReportModel{
+			ReportType: propString(props, "xmlReportParsing.reportType"),
+			Rules:      splitStrings(props["xmlReportParsing.reportDirs"], "\n"),
+			Verbose:    types.BoolValue(props["xmlReportParsing.verboseOutput"] == "true"),
+		}
+
+	case sshAgentFeature:
+		var passphrase types.String
+		if prior.SshAgent != nil {
+			passphrase = prior.SshAgent.Passphrase
+		}
+		state.SshAgent = &models.SshAgentModel{
+			SshKey:     propString(props, "teamcitySshKey"),
+			Passphrase: passphrase,
+		}
+	}
+
+	return state
+}
+
+func appendHostingProps(props []models.Property, keys hostingKeys, serverUrl, authType, username, accessToken, tokenId types.String) []models.Property {
+	if keys.ServerUrl != "" {
+		props = appendProp(props, keys.ServerUrl, serverUrl)
+	}
+
+	switch authType.ValueString() {
+	case "stored_token":
+		props = appendProp(props, keys.AuthType, types.StringValue("storedToken"))
+		props = appendProp(props, "tokenId", tokenId)
+	case "vcs_root":
+		props = appendProp(props, keys.AuthType, types.StringValue("vcsRoot"))
+	default:
+		props = appendProp(props, keys.AuthType, types.StringValue(keys.TokenAuth))
+		if keys.Username != "" {
+			props = appendProp(props, keys.Username, username)
+		}
+		props = appendProp(props, keys.AccessToken, accessToken)
+	}
+
+	return props
+}
+
+func readHostingProps(props map[string]string, keys hostingKeys) (serverUrl, authType, username, tokenId types.String) {
+	serverUrl = types.StringNull()
+	if keys.ServerUrl != "" {
+		serverUrl = propString(props, keys.ServerUrl)
+	}
+
+	switch props[keys.AuthType] {
+	case "storedToken":
+		authType = types.StringValue("stored_token")
+	case "vcsRoot":
+		authType = types.StringValue("vcs_root")
+	default:
+		authType = types.StringValue("token")
+	}
+
+	username = types.StringNull()
+	if keys.Username != "" {
+		username = propString(props, keys.Username)
+	}
+
+	return serverUrl, authType, username, propString(props, "tokenId")
+}
+
+// hostingName maps the TeamCity identifier of a code hosting back to its name in the typed block.
+func hostingName(hostings map[string]hostingKeys, id string, prior types.String) string {
+	for name, keys := range hostings {
+		if keys.Id == id {
+			return name
+		}
+	}
+	return prior.ValueString()
+}
+
+func appendProp(props []models.Property, name string, value types.String) []models.Property {
+	if value.IsNull() || value.IsUnknown() {
+		return props
+	}
+	return append(props, models.Property{Name: name, Value: value.ValueString()})
+}
+
+func propString(props map[string]string, name string) types.String {
+	if value, ok := props[name]; ok {
+		return types.StringValue(value)
+	}
+	return types.StringNull()
+}
+
+func joinStrings(values []types.String, sep string) string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+	return strings.Join(result, sep)
+}
+
+func splitStrings(value, sep string) []types.String {
+	var result []types.String
+	for _, v := range strings.Split(value, sep) {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			result = append(result, types.StringValue(v))
+		}
+	}
+	return result
+}
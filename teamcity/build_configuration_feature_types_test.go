@@ -0,0 +1,206 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func propsOf(props []models.Property) map[string]string {
+	result := make(map[string]string, len(props))
+	for _, p := range props {
+		result[p.Name] = p.Value
+	}
+	return result
+}
+
+// Typed blocks must produce the property keys TeamCity expects and read them
+// back unchanged, otherwise every apply would end with a perpetual diff.
+func TestTypedFeature_CommitStatusPublisherRoundTrip(t *testing.T) {
+	plan := models.BuildFeatureDataModel{
+		CommitStatusPublisher: &models.CommitStatusPublisherModel{
+			Publisher:   types.StringValue("github"),
+			VcsRootId:   types.StringValue("Project_Repo"),
+			ServerUrl:   types.StringValue("https://api.github.com"),
+			AuthType:    types.StringValue("token"),
+			Username:    types.StringNull(),
+			AccessToken: types.StringValue("secret"),
+			TokenId:     types.StringNull(),
+		},
+	}
+
+	if got := typedFeatureType(plan); got != commitStatusPublisherFeature {
+		t.Fatalf("expected type %q, got %q", commitStatusPublisherFeature, got)
+	}
+
+	props := typedFeatureProperties(plan)
+	want := map[string]string{
+		"publisherId":                "githubStatusPublisher",
+		"vcsRootId":                  "Project_Repo",
+		"github_host":                "https://api.github.com",
+		"github_authType":            "token",
+		"secure:github_access_token": "secret",
+	}
+	got := propsOf(props)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d properties, got %d: %v", len(want), len(got), got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("property %q: expected %q, got %q", k, v, got[k])
+		}
+	}
+
+	// secure values are not returned by the server
+	var server []models.Property
+	for _, p := range props {
+		if p.Name != "secure:github_access_token" {
+			server = append(server, p)
+		}
+	}
+
+	state := readTypedFeature(commitStatusPublisherFeature, &models.Properties{Property: server}, plan)
+	if *state.CommitStatusPublisher != *plan.CommitStatusPublisher {
+		t.Fatalf("round trip mismatch: expected %+v, got %+v", *plan.CommitStatusPublisher, *state.CommitStatusPublisher)
+	}
+}
+
+func TestTypedFeature_PullRequestsStoredToken(t *testing.T) {
+	plan := models.BuildFeatureDataModel{
+		PullRequests: &models.PullRequestsModel{
+			Provider:           types.StringValue("bitbucket_cloud"),
+			VcsRootId:          types.StringNull(),
+			ServerUrl:          types.StringUnknown(),
+			AuthType:           types.StringValue("stored_token"),
+			Username:           types.StringNull(),
+			AccessToken:        types.StringNull(),
+			TokenId:            types.StringValue("tc_token_id:CID_1:-1:1"),
+			FilterSourceBranch: types.StringNull(),
+			FilterTargetBranch: types.StringValue("+:main"),
+			FilterAuthorRole:   types.StringNull(),
+			IgnoreDrafts:       types.BoolValue(true),
+		},
+	}
+
+	got := propsOf(typedFeatureProperties(plan))
+	want := map[string]string{
+		"providerType":       "bitbucketCloud",
+		"authenticationType": "storedToken",
+		"tokenId":            "tc_token_id:CID_1:-1:1",
+		"filterTargetBranch": "+:main",
+		"ignoreDrafts":       "true",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d properties, got %d: %v", len(want), len(got), got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("property %q: expected %q, got %q", k, v, got[k])
+		}
+	}
+
+	state := readTypedFeature(pullRequestsFeature, &models.Properties{Property: typedFeatureProperties(plan)}, plan)
+	if !state.PullRequests.ServerUrl.IsNull() {
+		t.Errorf("expected null server_url for bitbucket_cloud, got %s", state.PullRequests.ServerUrl)
+	}
+	if state.PullRequests.AuthType.ValueString() != "stored_token" {
+		t.Errorf("expected stored_token auth type, got %s", state.PullRequests.AuthType)
+	}
+}
+
+func TestTypedFeature_ListsRoundTrip(t *testing.T) {
+	plan := models.BuildFeatureDataModel{
+		XmlReport: &models.XmlReportModel{
+			ReportType: types.StringValue("junit"),
+			Rules:      []types.String{types.StringValue("+:build/test-results/**/*.xml"), types.StringValue("-:build/tmp/*.xml")},
+			Verbose:    types.BoolValue(false),
+		},
+	}
+
+	props := typedFeatureProperties(plan)
+	if got := propsOf(props)["xmlReportParsing.reportDirs"]; got != "+:build/test-results/**/*.xml\n-:build/tmp/*.xml" {
+		t.Fatalf("unexpected report dirs: %q", got)
+	}
+
+	state := readTypedFeature(xmlReportFeature, &models.Properties{Property: props}, plan)
+	if len(state.XmlReport.Rules) != 2 || !state.XmlReport.Rules[1].Equal(plan.XmlReport.Rules[1]) {
+		t.Fatalf("round trip mismatch: %v", state.XmlReport.Rules)
+	}
+	if state.DockerSupport != nil || state.CommitStatusPublisher != nil {
+		t.Fatalf("blocks not used in the prior state must stay empty")
+	}
+}
+
+// An imported feature has no prior type, Read picks the block from the server type.
+func TestTypedFeature_Import(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/app/rest/buildTypes/id:Build/features/SSH_1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"SSH_1","type":"ssh-agent","properties":{"property":[{"name":"teamcitySshKey","value":"deploy-key"}]}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := client.NewClient(server.URL, "token", "", "", 0)
+	r := &bcFeatureResource{client: &c}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	imported := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := imported.Set(ctx, models.BuildFeatureDataModel{
+		ID:                   types.StringValue("Build/SSH_1"),
+		BuildConfigurationId: types.StringValue("Build"),
+		Type:                 types.StringNull(),
+		Properties:           types.MapNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	resp := resource.ReadResponse{State: imported}
+	r.Read(ctx, resource.ReadRequest{State: imported}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var state models.BuildFeatureDataModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if state.Type.ValueString() != sshAgentFeature {
+		t.Errorf("expected type %s, got %s", sshAgentFeature, state.Type)
+	}
+	if state.SshAgent == nil || state.SshAgent.SshKey.ValueString() != "deploy-key" {
+		t.Fatalf("expected the ssh_agent block to be read, got %+v", state.SshAgent)
+	}
+	if !state.SshAgent.Passphrase.IsNull() {
+		t.Errorf("expected null passphrase, got %s", state.SshAgent.Passphrase)
+	}
+}
+
+// Features managed with type and properties must not get a typed block.
+func TestTypedFeature_GenericFeature(t *testing.T) {
+	prior := models.BuildFeatureDataModel{
+		Type: types.StringValue(perfmonFeature),
+	}
+
+	state := readTypedFeature(perfmonFeature, nil, prior)
+	if state.Perfmon != nil {
+		t.Fatalf("expected no typed block for a generic feature")
+	}
+}
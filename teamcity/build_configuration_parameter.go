@@ -19,10 +19,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &bcParamResource{}
-	_ resource.ResourceWithConfigure   = &bcParamResource{}
-	_ resource.ResourceWithImportState = &bcParamResource{}
-	_ resource.ResourceWithModifyPlan  = &bcParamResource{}
+	_ resource.Resource                   = &bcParamResource{}
+	_ resource.ResourceWithConfigure      = &bcParamResource{}
+	_ resource.ResourceWithImportState    = &bcParamResource{}
+	_ resource.ResourceWithModifyPlan     = &bcParamResource{}
+	_ resource.ResourceWithValidateConfig = &bcParamResource{}
 )
 
 func NewBuildConfigurationParamResource() resource.Resource {
@@ -38,6 +39,8 @@ type bcParamResourceModel struct {
 	BuildConfigurationId types.String `tfsdk:"build_configuration_id"`
 	Name                 types.String `tfsdk:"name"`
 	Value                types.String `tfsdk:"value"`
+	ValueWo              types.String `tfsdk:"value_wo"`
+	Version              types.Int64  `tfsdk:"value_wo_version"`
 	Type                 types.String `tfsdk:"type"`
 }
 
@@ -69,9 +72,11 @@ func (r *bcParamResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"value": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
+			"value_wo":         writeOnlyAttribute("value", true),
+			"value_wo_version": writeOnlyVersionAttribute("value"),
 			"type": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -104,12 +109,18 @@ func (r *bcParamResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	valueWo := writeOnlyValue(ctx, req.Config, path.Root("value_wo"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	name := plan.Name.ValueString()
+	value := secretValue(plan.Value, valueWo).ValueString()
 	var err error
 	if isSecureBCParam(plan) {
-		err = r.client.SecureSetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, plan.Value.ValueString())
+		err = r.client.SecureSetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, value)
 	} else {
-		err = r.client.SetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, plan.Value.ValueString())
+		err = r.client.SetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, value)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -124,6 +135,7 @@ func (r *bcParamResource) Create(ctx context.Context, req resource.CreateRequest
 	newState.BuildConfigurationId = plan.BuildConfigurationId
 	newState.Name = plan.Name
 	newState.Value = plan.Value
+	newState.Version = plan.Version
 	if plan.Type.IsNull() || plan.Type.ValueString() == "" {
 		newState.Type = types.StringValue(models.ParamTypeText)
 	} else {
@@ -150,6 +162,7 @@ func (r *bcParamResource) Read(ctx context.Context, req resource.ReadRequest, re
 	newState.Id = types.StringValue(fmt.Sprintf("%s/%s", oldState.BuildConfigurationId.ValueString(), name))
 	newState.BuildConfigurationId = oldState.BuildConfigurationId
 	newState.Name = oldState.Name
+	newState.Version = oldState.Version
 
 	isPassword := isSecureBCParam(oldState)
 	if isPassword {
@@ -201,13 +214,20 @@ func (r *bcParamResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	if !plan.Value.Equal(oldState.Value) {
+	// The write-only value is sent again when its version changes
+	if !plan.Value.Equal(oldState.Value) || !plan.Version.Equal(oldState.Version) {
+		valueWo := writeOnlyValue(ctx, req.Config, path.Root("value_wo"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		name := plan.Name.ValueString()
+		value := secretValue(plan.Value, valueWo).ValueString()
 		var err error
 		if isSecureBCParam(plan) {
-			err = r.client.SecureSetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, plan.Value.ValueString())
+			err = r.client.SecureSetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, value)
 		} else {
-			err = r.client.SetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, plan.Value.ValueString())
+			err = r.client.SetBuildTypeParam(plan.BuildConfigurationId.ValueString(), name, value)
 		}
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -223,6 +243,7 @@ func (r *bcParamResource) Update(ctx context.Context, req resource.UpdateRequest
 	newState.BuildConfigurationId = plan.BuildConfigurationId
 	newState.Name = plan.Name
 	newState.Value = plan.Value
+	newState.Version = plan.Version
 	if plan.Type.IsNull() || plan.Type.ValueString() == "" {
 		newState.Type = types.StringValue(models.ParamTypeText)
 	} else {
@@ -255,6 +276,24 @@ func (r *bcParamResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// ValidateConfig allows write-only values for password parameters only, the
+// value of the other parameters is read back from the server.
+func (r *bcParamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config bcParamResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ValueWo.IsNull() && !config.Type.IsUnknown() && !strings.EqualFold(config.Type.ValueString(), models.ParamTypePassword) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value_wo"),
+			"Invalid write-only value",
+			"value_wo can only be used with type = \"password\"",
+		)
+	}
+}
+
 func (r *bcParamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
 
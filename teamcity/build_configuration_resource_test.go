@@ -186,3 +186,66 @@ data "teamcity_build_configuration" "test" {
 		},
 	})
 }
+
+func TestAccBuildConfigurationDataSource_details(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "test" {
+	name = "test_project_ds_details"
+}
+
+resource "teamcity_build_configuration" "test" {
+	name       = "test_bc_ds_details"
+	project_id = teamcity_project.test.id
+}
+
+resource "teamcity_build_configuration_step" "test" {
+	build_configuration_id = teamcity_build_configuration.test.id
+	name                   = "hello"
+	type                   = "simpleRunner"
+	properties = {
+		"script.content"    = "echo Hello World"
+		"use.custom.script" = "true"
+	}
+}
+
+resource "teamcity_build_configuration_parameter" "text" {
+	build_configuration_id = teamcity_build_configuration.test.id
+	name                   = "PUBLIC_PARAM"
+	value                  = "visible"
+}
+
+resource "teamcity_build_configuration_parameter" "secret" {
+	build_configuration_id = teamcity_build_configuration.test.id
+	name                   = "SECRET_PARAM"
+	value                  = "hidden"
+	type                   = "password"
+}
+
+data "teamcity_build_configuration" "test" {
+	id = teamcity_build_configuration.test.id
+
+	depends_on = [
+		teamcity_build_configuration_step.test,
+		teamcity_build_configuration_parameter.text,
+		teamcity_build_configuration_parameter.secret,
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "steps.#", "1"),
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "steps.0.name", "hello"),
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "steps.0.type", "simpleRunner"),
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "steps.0.properties.script.content", "echo Hello World"),
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "parameters.PUBLIC_PARAM", "visible"),
+					resource.TestCheckNoResourceAttr("data.teamcity_build_configuration.test", "parameters.SECRET_PARAM"),
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "triggers.#", "0"),
+					resource.TestCheckResourceAttr("data.teamcity_build_configuration.test", "templates.#", "0"),
+				),
+			},
+		},
+	})
+}
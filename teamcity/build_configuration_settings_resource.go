@@ -2,20 +2,29 @@ package teamcity
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 	"strconv"
 	"terraform-provider-teamcity/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &bcSettingsResource{}
-	_ resource.ResourceWithConfigure = &bcSettingsResource{}
+	_ resource.Resource                   = &bcSettingsResource{}
+	_ resource.ResourceWithConfigure      = &bcSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &bcSettingsResource{}
 )
 
 func NewBuildConfigurationSettingsResource() resource.Resource {
@@ -32,6 +41,55 @@ type bcSettingsResourceModel struct {
 	BuildNumberCounter   types.Int64  `tfsdk:"build_number_counter"`
 	BuildNumberPattern   types.String `tfsdk:"build_number_pattern"`
 	ArtifactRules        types.String `tfsdk:"artifact_rules"`
+	ExecutionTimeout     types.Int64  `tfsdk:"execution_timeout"`
+	FailOnExitCode       types.Bool   `tfsdk:"fail_on_exit_code"`
+	FailOnErrorMessage   types.Bool   `tfsdk:"fail_on_error_message"`
+	FailOnOutOfMemory    types.Bool   `tfsdk:"fail_on_out_of_memory"`
+	FailOnTestFailure    types.Bool   `tfsdk:"fail_on_test_failure"`
+	MaxRunningBuilds     types.Int64  `tfsdk:"max_running_builds"`
+	AllowPersonalBuilds  types.Bool   `tfsdk:"allow_personal_builds"`
+	PublishArtifacts     types.String `tfsdk:"publish_artifacts"`
+	CleanBuild           types.Bool   `tfsdk:"clean_build"`
+	CheckoutMode         types.String `tfsdk:"checkout_mode"`
+	CheckoutDirectory    types.String `tfsdk:"checkout_directory"`
+	ShowDependencies     types.Bool   `tfsdk:"show_dependencies_changes"`
+	TestRetry            types.Bool   `tfsdk:"test_retry"`
+	BuildDefaultBranch   types.Bool   `tfsdk:"build_default_branch"`
+	BranchFilter         types.String `tfsdk:"branch_filter"`
+	VcsLabelingFilter    types.String `tfsdk:"vcs_labeling_branch_filter"`
+	HangingBuildDetect   types.Bool   `tfsdk:"hanging_build_detection"`
+}
+
+// bcSetting binds a model attribute to a TeamCity build configuration setting.
+type bcSetting struct {
+	name  string // TeamCity setting name
+	reset string // TeamCity default restored when the resource is deleted
+	value any    // *types.String, *types.Int64 or *types.Bool
+}
+
+func (m *bcSettingsResourceModel) settings() []bcSetting {
+	return []bcSetting{
+		{name: "buildNumberCounter", reset: "1", value: &m.BuildNumberCounter},
+		{name: "buildNumberPattern", reset: "%build.counter%", value: &m.BuildNumberPattern},
+		{name: "artifactRules", reset: "", value: &m.ArtifactRules},
+		{name: "executionTimeoutMin", reset: "0", value: &m.ExecutionTimeout},
+		{name: "shouldFailBuildOnBadExitCode", reset: "true", value: &m.FailOnExitCode},
+		{name: "shouldFailBuildOnAnyErrorMessage", reset: "false", value: &m.FailOnErrorMessage},
+		{name: "shouldFailBuildOnOOMEOrCrash", reset: "true", value: &m.FailOnOutOfMemory},
+		{name: "shouldFailBuildIfTestsFailed", reset: "true", value: &m.FailOnTestFailure},
+		{name: "maximumNumberOfBuilds", reset: "0", value: &m.MaxRunningBuilds},
+		{name: "allowPersonalBuildTriggering", reset: "true", value: &m.AllowPersonalBuilds},
+		{name: "publishArtifactCondition", reset: "NORMALLY_FINISHED", value: &m.PublishArtifacts},
+		{name: "cleanBuild", reset: "false", value: &m.CleanBuild},
+		{name: "checkoutMode", reset: "ON_AGENT", value: &m.CheckoutMode},
+		{name: "checkoutDirectory", reset: "", value: &m.CheckoutDirectory},
+		{name: "showDependenciesChanged", reset: "false", value: &m.ShowDependencies},
+		{name: "supportTestRetry", reset: "false", value: &m.TestRetry},
+		{name: "buildDefaultBranch", reset: "true", value: &m.BuildDefaultBranch},
+		{name: "branchFilter", reset: "+:*", value: &m.BranchFilter},
+		{name: "vcsLabelingBranchFilter", reset: "+:<default>", value: &m.VcsLabelingFilter},
+		{name: "enableHangingBuildsDetection", reset: "true", value: &m.HangingBuildDetect},
+	}
 }
 
 func (r *bcSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +98,7 @@ func (r *bcSettingsResource) Metadata(_ context.Context, req resource.MetadataRe
 
 func (r *bcSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "General settings for a build configuration, including build number counter, pattern, and artifact rules.",
+		Description: "General settings for a build configuration, including build number counter, pattern, artifact rules, checkout options and the basic failure conditions.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -70,10 +128,124 @@ func (r *bcSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Description: "Rules for artifacts produced by the build.",
 			},
+			"execution_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Fail the build if it runs longer than the specified number of minutes. `0` means no timeout.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fail_on_exit_code": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Fail the build if a build step exits with a non-zero exit code.",
+			},
+			"fail_on_error_message": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Fail the build if an error message is logged by the build runner.",
+			},
+			"fail_on_out_of_memory": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Fail the build if an out of memory error or a crash is detected (Java only).",
+			},
+			"fail_on_test_failure": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Fail the build if at least one test failed.",
+			},
+			"max_running_builds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Limit the number of simultaneously running builds. `0` means unlimited.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"allow_personal_builds": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow triggering personal builds.",
+			},
+			"publish_artifacts": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "When to publish artifacts. Values: `SUCCESSFUL`, `NORMALLY_FINISHED`, `ALWAYS`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"SUCCESSFUL", "NORMALLY_FINISHED", "ALWAYS"}...),
+				},
+			},
+			"clean_build": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Delete all files in the checkout directory before the build.",
+			},
+			"checkout_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "VCS checkout mode. Values: `ON_AGENT`, `ON_SERVER`, `MANUAL`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"ON_AGENT", "ON_SERVER", "MANUAL"}...),
+				},
+			},
+			"checkout_directory": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Custom checkout directory. Empty means the directory is chosen by the agent. Required for the `MANUAL` checkout mode.",
+			},
+			"show_dependencies_changes": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Show the changes of snapshot dependencies in the build.",
+			},
+			"test_retry": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Treat tests which failed and then passed on retry within the same build as successful.",
+			},
+			"build_default_branch": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow building the default branch.",
+			},
+			"branch_filter": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Branch filter limiting the branches of the VCS roots built by the build configuration, one `+:` or `-:` rule per line.",
+			},
+			"vcs_labeling_branch_filter": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Branch filter for VCS labeling.",
+			},
+			"hanging_build_detection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Detect hanging builds.",
+			},
 		},
 	}
 }
 
+func (r *bcSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config bcSettingsResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.CheckoutMode.ValueString() == "MANUAL" && !config.CheckoutDirectory.IsUnknown() && config.CheckoutDirectory.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("checkout_directory"),
+			"'checkout_directory' must be specified for the 'MANUAL' checkout mode",
+			"",
+		)
+	}
+}
+
 func (r *bcSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -91,28 +263,24 @@ func (r *bcSettingsResource) Create(ctx context.Context, req resource.CreateRequ
 
 	buildTypeId := plan.BuildConfigurationId.ValueString()
 
-	if !plan.BuildNumberCounter.IsNull() {
-		err := r.client.SetBuildTypeSetting(buildTypeId, "buildNumberCounter", strconv.FormatInt(plan.BuildNumberCounter.ValueInt64(), 10))
-		if err != nil {
-			resp.Diagnostics.AddError("Error setting buildNumberCounter", err.Error())
-			return
+	for _, s := range plan.settings() {
+		value, ok := settingString(s.value)
+		if !ok {
+			continue
 		}
-	}
-
-	if !plan.BuildNumberPattern.IsNull() {
-		err := r.client.SetBuildTypeSetting(buildTypeId, "buildNumberPattern", plan.BuildNumberPattern.ValueString())
+		err := r.client.SetBuildTypeSetting(buildTypeId, s.name, value)
 		if err != nil {
-			resp.Diagnostics.AddError("Error setting buildNumberPattern", err.Error())
+			resp.Diagnostics.AddError("Error setting "+s.name, err.Error())
 			return
 		}
 	}
 
-	if !plan.ArtifactRules.IsNull() {
-		err := r.client.SetBuildTypeSetting(buildTypeId, "artifactRules", plan.ArtifactRules.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Error setting artifactRules", err.Error())
-			return
-		}
+	resp.Diagnostics.Append(setManagedSettings(ctx, resp.Private, plan)...)
+
+	// Fill the settings left to the server defaults
+	if err := r.readSettings(buildTypeId, &plan); err != nil {
+		resp.Diagnostics.AddError("Error reading settings", err.Error())
+		return
 	}
 
 	plan.Id = types.StringValue(buildTypeId)
@@ -131,33 +299,10 @@ func (r *bcSettingsResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	buildTypeId := state.BuildConfigurationId.ValueString()
 
-	counter, err := r.client.GetBuildTypeSetting(buildTypeId, "buildNumberCounter")
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading buildNumberCounter", err.Error())
-		return
-	}
-	if counter != nil {
-		val, _ := strconv.ParseInt(*counter, 10, 64)
-		state.BuildNumberCounter = types.Int64Value(val)
-	}
-
-	pattern, err := r.client.GetBuildTypeSetting(buildTypeId, "buildNumberPattern")
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading buildNumberPattern", err.Error())
+	if err := r.readSettings(buildTypeId, &state); err != nil {
+		resp.Diagnostics.AddError("Error reading settings", err.Error())
 		return
 	}
-	if pattern != nil {
-		state.BuildNumberPattern = types.StringValue(*pattern)
-	}
-
-	rules, err := r.client.GetBuildTypeSetting(buildTypeId, "artifactRules")
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading artifactRules", err.Error())
-		return
-	}
-	if rules != nil {
-		state.ArtifactRules = types.StringValue(*rules)
-	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -180,28 +325,27 @@ func (r *bcSettingsResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	buildTypeId := plan.BuildConfigurationId.ValueString()
 
-	if !plan.BuildNumberCounter.Equal(state.BuildNumberCounter) {
-		err := r.client.SetBuildTypeSetting(buildTypeId, "buildNumberCounter", strconv.FormatInt(plan.BuildNumberCounter.ValueInt64(), 10))
-		if err != nil {
-			resp.Diagnostics.AddError("Error updating buildNumberCounter", err.Error())
-			return
+	current := state.settings()
+	for i, s := range plan.settings() {
+		value, ok := settingString(s.value)
+		if !ok {
+			continue
 		}
-	}
-
-	if !plan.BuildNumberPattern.Equal(state.BuildNumberPattern) {
-		err := r.client.SetBuildTypeSetting(buildTypeId, "buildNumberPattern", plan.BuildNumberPattern.ValueString())
+		if old, _ := settingString(current[i].value); old == value {
+			continue
+		}
+		err := r.client.SetBuildTypeSetting(buildTypeId, s.name, value)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating buildNumberPattern", err.Error())
+			resp.Diagnostics.AddError("Error updating "+s.name, err.Error())
 			return
 		}
 	}
 
-	if !plan.ArtifactRules.Equal(state.ArtifactRules) {
-		err := r.client.SetBuildTypeSetting(buildTypeId, "artifactRules", plan.ArtifactRules.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Error updating artifactRules", err.Error())
-			return
-		}
+	resp.Diagnostics.Append(setManagedSettings(ctx, resp.Private, plan)...)
+
+	if err := r.readSettings(buildTypeId, &plan); err != nil {
+		resp.Diagnostics.AddError("Error reading settings", err.Error())
+		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
@@ -218,15 +362,134 @@ func (r *bcSettingsResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	buildTypeId := state.BuildConfigurationId.ValueString()
 
-	// Settings cannot be deleted, only reset to defaults.
-	// We reset them to TeamCity defaults.
-	if err := r.client.SetBuildTypeSetting(buildTypeId, "buildNumberCounter", "1"); err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error resetting buildNumberCounter", err.Error())
+	managed, diags := req.Private.GetKey(ctx, managedSettingsKey)
+	resp.Diagnostics.Append(diags...)
+	// State written before the managed settings were recorded has no key
+	names := defaultManagedSettings
+	if managed != nil {
+		if err := json.Unmarshal(managed, &names); err != nil {
+			resp.Diagnostics.AddError("Error reading managed settings", err.Error())
+			return
+		}
+	}
+
+	// Settings cannot be deleted, only reset to defaults. Settings which
+	// were not configured keep the values set in the UI or by other tools.
+	for _, s := range state.settings() {
+		if !slices.Contains(names, s.name) {
+			continue
+		}
+		if err := r.client.SetBuildTypeSetting(buildTypeId, s.name, s.reset); err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError("Error resetting "+s.name, err.Error())
+		}
+	}
+}
+
+// managedSettingsKey is the private state key of the settings set from the
+// configuration, the state holds the server values of all settings.
+const managedSettingsKey = "managed_settings"
+
+// defaultManagedSettings are reset on delete when the private state has no
+// managed settings, as done before they were recorded.
+var defaultManagedSettings = []string{"buildNumberCounter", "buildNumberPattern", "artifactRules"}
+
+type privateState interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setManagedSettings records the settings with a configured value, the other
+// settings are unknown in the plan.
+func setManagedSettings(ctx context.Context, private privateState, plan bcSettingsResourceModel) diag.Diagnostics {
+	names := []string{}
+	for _, s := range plan.settings() {
+		if _, ok := settingString(s.value); ok {
+			names = append(names, s.name)
+		}
 	}
-	if err := r.client.SetBuildTypeSetting(buildTypeId, "buildNumberPattern", "%build.counter%"); err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error resetting buildNumberPattern", err.Error())
+	value, err := json.Marshal(names)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error saving managed settings", err.Error())
+		return diags
 	}
-	if err := r.client.SetBuildTypeSetting(buildTypeId, "artifactRules", ""); err != nil && !errors.Is(err, client.ErrNotFound) {
-		resp.Diagnostics.AddError("Error resetting artifactRules", err.Error())
+	return private.SetKey(ctx, managedSettingsKey, value)
+}
+
+// readSettings refreshes all settings of the model from the server. Settings
+// unknown to the server are left untouched.
+func (r *bcSettingsResource) readSettings(buildTypeId string, m *bcSettingsResourceModel) error {
+	for _, s := range m.settings() {
+		value, err := r.client.GetBuildTypeSetting(buildTypeId, s.name)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", s.name, err)
+		}
+		if value == nil {
+			// Not supported by this server, don't leave computed values unknown
+			clearUnknownSetting(s.value)
+			continue
+		}
+		if err := setSettingValue(s.value, *value); err != nil {
+			return fmt.Errorf("parsing %s: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+// settingString returns the TeamCity representation of a setting value, false if the value is not set.
+func settingString(value any) (string, bool) {
+	switch v := value.(type) {
+	case *types.String:
+		if v.IsNull() || v.IsUnknown() {
+			return "", false
+		}
+		return v.ValueString(), true
+	case *types.Int64:
+		if v.IsNull() || v.IsUnknown() {
+			return "", false
+		}
+		return strconv.FormatInt(v.ValueInt64(), 10), true
+	case *types.Bool:
+		if v.IsNull() || v.IsUnknown() {
+			return "", false
+		}
+		return strconv.FormatBool(v.ValueBool()), true
+	}
+	return "", false
+}
+
+func clearUnknownSetting(value any) {
+	switch v := value.(type) {
+	case *types.String:
+		if v.IsUnknown() {
+			*v = types.StringNull()
+		}
+	case *types.Int64:
+		if v.IsUnknown() {
+			*v = types.Int64Null()
+		}
+	case *types.Bool:
+		if v.IsUnknown() {
+			*v = types.BoolNull()
+		}
+	}
+}
+
+func setSettingValue(value any, raw string) error {
+	switch v := value.(type) {
+	case *types.String:
+		*v = types.StringValue(raw)
+	case *types.Int64:
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		*v = types.Int64Value(parsed)
+	case *types.Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		*v = types.BoolValue(parsed)
 	}
+	return nil
 }
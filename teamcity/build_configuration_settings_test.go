@@ -58,9 +58,16 @@ resource "teamcity_build_configuration_settings" "s" {
   build_number_counter   = 456
   build_number_pattern   = "release-%build.counter%"
   artifact_rules         = "+:dist/*.zip"
+  execution_timeout      = 30
+  fail_on_exit_code      = false
+  fail_on_error_message  = true
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "execution_timeout", "30"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "fail_on_exit_code", "false"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "fail_on_error_message", "true"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "fail_on_out_of_memory", "true"),
 					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "build_number_counter", "456"),
 					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "build_number_pattern", "release-%build.counter%"),
 					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "artifact_rules", "+:dist/*.zip"),
@@ -70,6 +77,69 @@ resource "teamcity_build_configuration_settings" "s" {
 	})
 }
 
+func TestAccBuildConfigurationSettings_general(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "p" {
+  name = "General Settings Project"
+  id   = "general_settings_project"
+}
+
+resource "teamcity_build_configuration" "bc" {
+  project_id = teamcity_project.p.id
+  name       = "General Settings BC"
+  id         = "general_settings_bc"
+}
+
+resource "teamcity_build_configuration_settings" "s" {
+  build_configuration_id     = teamcity_build_configuration.bc.id
+  max_running_builds         = 2
+  allow_personal_builds      = false
+  publish_artifacts          = "ALWAYS"
+  clean_build                = true
+  checkout_mode              = "MANUAL"
+  checkout_directory         = "src"
+  show_dependencies_changes  = true
+  test_retry                 = true
+  build_default_branch       = false
+  branch_filter              = "+:main\n+:release/*"
+  vcs_labeling_branch_filter = "+:main"
+  hanging_build_detection    = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "max_running_builds", "2"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "allow_personal_builds", "false"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "publish_artifacts", "ALWAYS"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "clean_build", "true"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "checkout_mode", "MANUAL"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "checkout_directory", "src"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "show_dependencies_changes", "true"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "test_retry", "true"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "build_default_branch", "false"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "branch_filter", "+:main\n+:release/*"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "vcs_labeling_branch_filter", "+:main"),
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "hanging_build_detection", "false"),
+				),
+			},
+			{
+				PreConfig: func() {
+					c := testAccClientFromEnv()
+					_ = c.SetBuildTypeSetting("general_settings_bc", "maximumNumberOfBuilds", "5")
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_build_configuration_settings.s", "max_running_builds", "5"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBuildConfigurationSettings_delete(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -92,6 +162,7 @@ resource "teamcity_build_configuration_settings" "s" {
   build_number_counter   = 789
   build_number_pattern   = "del-%build.counter%"
   artifact_rules         = "+:del/*"
+  execution_timeout      = 15
 }
 `,
 			},
@@ -108,8 +179,16 @@ resource "teamcity_build_configuration" "bc" {
   id         = "del_settings_bc"
 }
 `,
+				// A setting changed outside of Terraform is not reset
+				PreConfig: func() {
+					c := testAccClientFromEnv()
+					if err := c.SetBuildTypeSetting("del_settings_bc", "cleanBuild", "true"); err != nil {
+						t.Fatal(err)
+					}
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckBuildConfigurationSettingsReset("del_settings_bc"),
+					testAccCheckBuildConfigurationSetting("del_settings_bc", "cleanBuild", "true"),
 				),
 			},
 		},
@@ -144,6 +223,29 @@ func testAccCheckBuildConfigurationSettingsReset(buildTypeId string) resource.Te
 			return fmt.Errorf("expected artifactRules to be empty, got %v", rules)
 		}
 
+		timeout, err := c.GetBuildTypeSetting(buildTypeId, "executionTimeoutMin")
+		if err != nil {
+			return err
+		}
+		if timeout == nil || *timeout != "0" {
+			return fmt.Errorf("expected executionTimeoutMin to be 0, got %v", timeout)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBuildConfigurationSetting(buildTypeId, name, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccClientFromEnv()
+
+		value, err := c.GetBuildTypeSetting(buildTypeId, name)
+		if err != nil {
+			return err
+		}
+		if value == nil || *value != expected {
+			return fmt.Errorf("expected %s to be %q, got %v", name, expected, value)
+		}
 		return nil
 	}
 }
@@ -0,0 +1,64 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"terraform-provider-teamcity/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// State written without the managed settings key resets the settings that
+// were always reset on delete.
+func TestBuildConfigurationSettings_DeleteWithoutPrivateState(t *testing.T) {
+	var mu sync.Mutex
+	var reset []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/app/rest/buildTypes/id:Build/settings/"
+		if r.Method != http.MethodPut || !strings.HasPrefix(r.URL.Path, prefix) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		reset = append(reset, strings.TrimPrefix(r.URL.Path, prefix))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := client.NewClient(server.URL, "token", "", "", 0)
+	r := &bcSettingsResource{client: &c}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("build_configuration_id"), types.StringValue("Build"))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	var resp resource.DeleteResponse
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	slices.Sort(reset)
+	want := []string{"artifactRules", "buildNumberCounter", "buildNumberPattern"}
+	if !slices.Equal(reset, want) {
+		t.Fatalf("expected %v to be reset, got %v", want, reset)
+	}
+}
@@ -0,0 +1,418 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &cleanupRuleResource{}
+	_ resource.ResourceWithConfigure      = &cleanupRuleResource{}
+	_ resource.ResourceWithImportState    = &cleanupRuleResource{}
+	_ resource.ResourceWithValidateConfig = &cleanupRuleResource{}
+)
+
+const keepRulesFeatureType = "keepRules"
+
+func NewCleanupRuleResource() resource.Resource {
+	return &cleanupRuleResource{}
+}
+
+type cleanupRuleResource struct {
+	client *client.Client
+}
+
+type cleanupRuleResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	ProjectId            types.String `tfsdk:"project_id"`
+	BuildConfigurationId types.String `tfsdk:"build_configuration_id"`
+	Keep                 types.String `tfsdk:"keep"`
+	Builds               types.Int64  `tfsdk:"builds"`
+	Days                 types.Int64  `tfsdk:"days"`
+	ArtifactPatterns     types.String `tfsdk:"artifact_patterns"`
+	BranchFilter         types.String `tfsdk:"branch_filter"`
+	PerBranch            types.Bool   `tfsdk:"per_branch"`
+	PreventDependencies  types.Bool   `tfsdk:"prevent_dependencies_cleanup"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *cleanupRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cleanup_rule"
+}
+
+func (r *cleanupRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A clean-up keep rule of a project or a build configuration, it defines which builds and data are preserved by the [clean-up](https://www.jetbrains.com/help/teamcity/teamcity-data-clean-up.html). Project rules apply to all its build configurations. The schedule of the clean-up is managed by `teamcity_cleanup_settings`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the project the rule belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("build_configuration_id")),
+				},
+			},
+			"build_configuration_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the build configuration the rule belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keep": schema.StringAttribute{
+				Required:    true,
+				Description: "Data of the matching builds to keep: `everything`, `history` (history and statistics), `statistics` or `artifacts`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"everything", "history", "statistics", "artifacts"}...),
+				},
+			},
+			"builds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Keep the data of the last N builds.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("days")),
+				},
+			},
+			"days": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Keep the data of the builds of the last N days. The data of all builds is kept when neither `builds` nor `days` is set.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"artifact_patterns": schema.StringAttribute{
+				Optional:    true,
+				Description: "Newline-delimited artifact patterns in the form of +|-:path, only with `keep = \"artifacts\"`. All artifacts are kept when omitted.",
+			},
+			"branch_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Newline-delimited branch filter in the form of +|-:branch name, the rule applies to all branches when omitted.",
+			},
+			"per_branch": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Apply the limit to every branch separately. Defaults to `false`.",
+			},
+			"prevent_dependencies_cleanup": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Also keep the artifacts of the builds the kept builds depend on. Defaults to `true`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the rule is applied. Defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (r *cleanupRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *cleanupRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var keep, patterns types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("keep"), &keep)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("artifact_patterns"), &patterns)...)
+	if keep.IsUnknown() || patterns.IsNull() {
+		return
+	}
+
+	if keep.ValueString() != "artifacts" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("artifact_patterns"),
+			"Invalid attribute combination",
+			"artifact_patterns can only be set with keep = \"artifacts\".",
+		)
+	}
+}
+
+func (r *cleanupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cleanupRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := keepRuleProperties(plan)
+	var result models.Properties
+	if !plan.ProjectId.IsNull() {
+		actual, err := r.client.NewProjectFeature(plan.ProjectId.ValueString(), models.ProjectFeatureJson{
+			Type:       keepRulesFeatureType,
+			Properties: props,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating cleanup rule",
+				err.Error(),
+			)
+			return
+		}
+		plan.Id = types.StringPointerValue(actual.Id)
+		result = actual.Properties
+	} else {
+		actual, err := r.client.NewBuildTypeFeature(plan.BuildConfigurationId.ValueString(), models.BuildFeatureJson{
+			Type:       keepRulesFeatureType,
+			Properties: &props,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating cleanup rule",
+				err.Error(),
+			)
+			return
+		}
+		plan.Id = types.StringValue(actual.ID)
+		if actual.Properties != nil {
+			result = *actual.Properties
+		}
+	}
+
+	readKeepRule(result, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cleanupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cleanupRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var props *models.Properties
+	if !state.ProjectId.IsNull() {
+		actual, err := r.client.GetProjectFeature(state.ProjectId.ValueString(), state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading cleanup rule",
+				err.Error(),
+			)
+			return
+		}
+		if actual != nil && actual.Type == keepRulesFeatureType {
+			props = &actual.Properties
+		}
+	} else {
+		actual, err := r.client.GetBuildTypeFeature(state.BuildConfigurationId.ValueString(), state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading cleanup rule",
+				err.Error(),
+			)
+			return
+		}
+		if actual != nil && actual.Type == keepRulesFeatureType {
+			props = &models.Properties{}
+			if actual.Properties != nil {
+				props = actual.Properties
+			}
+		}
+	}
+
+	if props == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	readKeepRule(*props, &state)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cleanupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cleanupRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	props := keepRuleProperties(plan)
+	var result models.Properties
+	if !plan.ProjectId.IsNull() {
+		actual, err := r.client.UpdateProjectFeature(plan.ProjectId.ValueString(), plan.Id.ValueString(), models.ProjectFeatureJson{
+			Id:         plan.Id.ValueStringPointer(),
+			Type:       keepRulesFeatureType,
+			Properties: props,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cleanup rule",
+				err.Error(),
+			)
+			return
+		}
+		result = actual.Properties
+	} else {
+		actual, err := r.client.UpdateBuildTypeFeature(plan.BuildConfigurationId.ValueString(), plan.Id.ValueString(), models.BuildFeatureJson{
+			ID:         plan.Id.ValueString(),
+			Type:       keepRulesFeatureType,
+			Properties: &props,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cleanup rule",
+				err.Error(),
+			)
+			return
+		}
+		if actual.Properties != nil {
+			result = *actual.Properties
+		}
+	}
+
+	readKeepRule(result, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cleanupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cleanupRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if !state.ProjectId.IsNull() {
+		err = r.client.DeleteProjectFeature(state.ProjectId.ValueString(), state.Id.ValueString())
+	} else {
+		err = r.client.DeleteBuildTypeFeature(state.BuildConfigurationId.ValueString(), state.Id.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting cleanup rule",
+			err.Error(),
+		)
+	}
+}
+
+func (r *cleanupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" || (parts[0] != "project" && parts[0] != "build_configuration") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be in the format: project/<project_id>/<rule_id> or build_configuration/<build_configuration_id>/<rule_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parts[0]+"_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+// keepRuleProperties converts the rule to the parameters of a keepRules feature.
+func keepRuleProperties(m cleanupRuleResourceModel) models.Properties {
+	var props []models.Property
+	add := func(name, value string) {
+		props = append(props, models.Property{Name: name, Value: value})
+	}
+
+	add("keepData.1.type", m.Keep.ValueString())
+	if !m.ArtifactPatterns.IsNull() {
+		add("keepData.1.artifactPatterns", m.ArtifactPatterns.ValueString())
+	}
+
+	switch {
+	case !m.Builds.IsNull():
+		add("limit.type", "lastNBuilds")
+		add("limit.buildsCount", strconv.FormatInt(m.Builds.ValueInt64(), 10))
+	case !m.Days.IsNull():
+		add("limit.type", "lastNDays")
+		add("limit.daysCount", strconv.FormatInt(m.Days.ValueInt64(), 10))
+	default:
+		add("limit.type", "all")
+	}
+
+	if !m.BranchFilter.IsNull() {
+		add("filters.1.type", "branchSpecs")
+		add("filters.1.pattern", m.BranchFilter.ValueString())
+	}
+	if m.PerBranch.ValueBool() {
+		add("partitions.1.type", "perBranch")
+	}
+
+	add("preserveArtifacts", strconv.FormatBool(m.PreventDependencies.ValueBool()))
+	add("ruleDisabled", strconv.FormatBool(!m.Enabled.ValueBool()))
+
+	return models.Properties{Property: props}
+}
+
+// readKeepRule fills in the rule from the parameters of a keepRules feature.
+func readKeepRule(properties models.Properties, m *cleanupRuleResourceModel) {
+	props := make(map[string]string)
+	for _, p := range properties.Property {
+		props[p.Name] = p.Value
+	}
+
+	optionalString := func(name string) types.String {
+		if value, ok := props[name]; ok && value != "" {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+	optionalInt := func(name string) types.Int64 {
+		if value, err := strconv.ParseInt(props[name], 10, 64); err == nil {
+			return types.Int64Value(value)
+		}
+		return types.Int64Null()
+	}
+
+	m.Keep = types.StringValue(props["keepData.1.type"])
+	m.ArtifactPatterns = optionalString("keepData.1.artifactPatterns")
+
+	m.Builds, m.Days = types.Int64Null(), types.Int64Null()
+	switch props["limit.type"] {
+	case "lastNBuilds":
+		m.Builds = optionalInt("limit.buildsCount")
+	case "lastNDays":
+		m.Days = optionalInt("limit.daysCount")
+	}
+
+	m.BranchFilter = types.StringNull()
+	if props["filters.1.type"] == "branchSpecs" {
+		m.BranchFilter = optionalString("filters.1.pattern")
+	}
+	m.PerBranch = types.BoolValue(props["partitions.1.type"] == "perBranch")
+	m.PreventDependencies = types.BoolValue(props["preserveArtifacts"] != "false")
+	m.Enabled = types.BoolValue(props["ruleDisabled"] != "true")
+}
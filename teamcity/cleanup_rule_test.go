@@ -0,0 +1,101 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCleanupRule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "test" {
+	name = "TestCleanupRule"
+}
+
+resource "teamcity_cleanup_rule" "test" {
+	project_id = teamcity_project.test.id
+	keep = "everything"
+	builds = 10
+	branch_filter = "+:<default>"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_cleanup_rule.test", "keep", "everything"),
+					resource.TestCheckResourceAttr("teamcity_cleanup_rule.test", "builds", "10"),
+					resource.TestCheckResourceAttr("teamcity_cleanup_rule.test", "prevent_dependencies_cleanup", "true"),
+					resource.TestCheckResourceAttrSet("teamcity_cleanup_rule.test", "id"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "test" {
+	name = "TestCleanupRule"
+}
+
+resource "teamcity_cleanup_rule" "test" {
+	project_id = teamcity_project.test.id
+	keep = "artifacts"
+	days = 7
+	artifact_patterns = "+:**/*.log"
+	per_branch = true
+	prevent_dependencies_cleanup = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_cleanup_rule.test", "keep", "artifacts"),
+					resource.TestCheckResourceAttr("teamcity_cleanup_rule.test", "days", "7"),
+					resource.TestCheckNoResourceAttr("teamcity_cleanup_rule.test", "builds"),
+					resource.TestCheckNoResourceAttr("teamcity_cleanup_rule.test", "branch_filter"),
+					resource.TestCheckResourceAttr("teamcity_cleanup_rule.test", "prevent_dependencies_cleanup", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestKeepRuleProperties(t *testing.T) {
+	rule := cleanupRuleResourceModel{
+		Keep:                types.StringValue("artifacts"),
+		Builds:              types.Int64Null(),
+		Days:                types.Int64Value(14),
+		ArtifactPatterns:    types.StringValue("+:**/*.zip"),
+		BranchFilter:        types.StringValue("+:main"),
+		PerBranch:           types.BoolValue(true),
+		PreventDependencies: types.BoolValue(false),
+		Enabled:             types.BoolValue(true),
+	}
+
+	props := map[string]string{}
+	for _, p := range keepRuleProperties(rule).Property {
+		props[p.Name] = p.Value
+	}
+	expected := map[string]string{
+		"keepData.1.type":             "artifacts",
+		"keepData.1.artifactPatterns": "+:**/*.zip",
+		"limit.type":                  "lastNDays",
+		"limit.daysCount":             "14",
+		"filters.1.type":              "branchSpecs",
+		"filters.1.pattern":           "+:main",
+		"partitions.1.type":           "perBranch",
+		"preserveArtifacts":           "false",
+		"ruleDisabled":                "false",
+	}
+	for name, value := range expected {
+		if props[name] != value {
+			t.Errorf("%s: expected %q, got %q", name, value, props[name])
+		}
+	}
+	if len(props) != len(expected) {
+		t.Errorf("unexpected properties: %v", props)
+	}
+
+	var read cleanupRuleResourceModel
+	readKeepRule(keepRuleProperties(rule), &read)
+	if read != rule {
+		t.Errorf("round trip mismatch: %+v", read)
+	}
+}
@@ -3,19 +3,25 @@ package teamcity
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
 	"terraform-provider-teamcity/client"
 	"terraform-provider-teamcity/models"
 )
 
 var (
-	_ resource.Resource              = &connectionResource{}
-	_ resource.ResourceWithConfigure = &connectionResource{}
+	_ resource.Resource                = &connectionResource{}
+	_ resource.ResourceWithConfigure   = &connectionResource{}
+	_ resource.ResourceWithImportState = &connectionResource{}
 )
 
 func NewConnectionResource() resource.Resource {
@@ -29,7 +35,8 @@ type connectionResource struct {
 type connectionResourceModel struct {
 	ProjectId types.String `tfsdk:"project_id"`
 	FeatureId types.String `tfsdk:"feature_id"`
-	GithubApp GithubApp    `tfsdk:"github_app"`
+	GithubApp *GithubApp   `tfsdk:"github_app"`
+	Slack     *Slack       `tfsdk:"slack"`
 }
 
 type GithubApp struct {
@@ -40,6 +47,25 @@ type GithubApp struct {
 	ClientSecret  types.String `tfsdk:"client_secret"`
 	PrivateKey    types.String `tfsdk:"private_key"`
 	WebhookSecret types.String `tfsdk:"webhook_secret"`
+
+	ClientSecretVersion  types.Int64  `tfsdk:"client_secret_wo_version"`
+	PrivateKeyVersion    types.Int64  `tfsdk:"private_key_wo_version"`
+	WebhookSecretVersion types.Int64  `tfsdk:"webhook_secret_wo_version"`
+	ClientSecretWo       types.String `tfsdk:"client_secret_wo"`
+	PrivateKeyWo         types.String `tfsdk:"private_key_wo"`
+	WebhookSecretWo      types.String `tfsdk:"webhook_secret_wo"`
+}
+
+type Slack struct {
+	DisplayName  types.String `tfsdk:"display_name"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	BotToken     types.String `tfsdk:"bot_token"`
+
+	ClientSecretVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	BotTokenVersion     types.Int64  `tfsdk:"bot_token_wo_version"`
+	ClientSecretWo      types.String `tfsdk:"client_secret_wo"`
+	BotTokenWo          types.String `tfsdk:"bot_token_wo"`
 }
 
 func (r *connectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,7 +74,7 @@ func (r *connectionResource) Metadata(_ context.Context, req resource.MetadataRe
 
 func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "TeamCity allows storing presets of connections to external services. Currently only GitHub App type is supported for adding SSO to the server. More info [here](https://www.jetbrains.com/help/teamcity/configuring-connections.html#GitHub)",
+		Description: "TeamCity allows storing presets of connections to external services. Currently GitHub App (used for adding SSO to the server) and Slack (used for notifications) types are supported. More info [here](https://www.jetbrains.com/help/teamcity/configuring-connections.html)",
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				Required: true,
@@ -63,7 +89,10 @@ func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"github_app": schema.SingleNestedAttribute{
-				Required: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					blockTypeRequiresReplace("connection"),
+				},
 				Attributes: map[string]schema.Attribute{
 					"display_name": schema.StringAttribute{
 						Required: true,
@@ -78,17 +107,56 @@ func (r *connectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 						Required: true,
 					},
 					"client_secret": schema.StringAttribute{
-						Required:  true,
+						Optional:  true,
 						Sensitive: true,
 					},
+					"client_secret_wo":         writeOnlyAttribute("client_secret", true),
+					"client_secret_wo_version": writeOnlyVersionAttribute("client_secret"),
 					"private_key": schema.StringAttribute{
-						Required:  true,
+						Optional:  true,
 						Sensitive: true,
 					},
+					"private_key_wo":         writeOnlyAttribute("private_key", true),
+					"private_key_wo_version": writeOnlyVersionAttribute("private_key"),
 					"webhook_secret": schema.StringAttribute{
-						Required:  true,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"webhook_secret_wo":         writeOnlyAttribute("webhook_secret", true),
+					"webhook_secret_wo_version": writeOnlyVersionAttribute("webhook_secret"),
+				},
+			},
+			"slack": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Slack connection used by the Slack notifier. More info [here](https://www.jetbrains.com/help/teamcity/configuring-connections.html#Slack)",
+				PlanModifiers: []planmodifier.Object{
+					blockTypeRequiresReplace("connection"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"display_name": schema.StringAttribute{
+						Required: true,
+					},
+					"client_id": schema.StringAttribute{
+						Required: true,
+					},
+					"client_secret": schema.StringAttribute{
+						Optional:  true,
 						Sensitive: true,
 					},
+					"client_secret_wo":         writeOnlyAttribute("client_secret", true),
+					"client_secret_wo_version": writeOnlyVersionAttribute("client_secret"),
+					"bot_token": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"bot_token_wo":         writeOnlyAttribute("bot_token", true),
+					"bot_token_wo_version": writeOnlyVersionAttribute("bot_token"),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRoot("github_app"),
+						path.MatchRoot("slack"),
+					),
 				},
 			},
 		},
@@ -112,7 +180,10 @@ func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequ
 
 	feature := models.ProjectFeatureJson{
 		Type: "OAuthProvider",
-		Properties: models.Properties{
+	}
+
+	if plan.GithubApp != nil {
+		feature.Properties = models.Properties{
 			Property: []models.Property{
 				{
 					Name:  "providerType",
@@ -140,18 +211,45 @@ func (r *connectionResource) Create(ctx context.Context, req resource.CreateRequ
 				},
 				{
 					Name:  "secure:gitHubApp.clientSecret",
-					Value: plan.GithubApp.ClientSecret.ValueString(),
+					Value: secretValue(plan.GithubApp.ClientSecret, writeOnlyValue(ctx, req.Config, path.Root("github_app").AtName("client_secret_wo"), &resp.Diagnostics)).ValueString(),
 				},
 				{
 					Name:  "secure:gitHubApp.privateKey",
-					Value: plan.GithubApp.PrivateKey.ValueString(),
+					Value: secretValue(plan.GithubApp.PrivateKey, writeOnlyValue(ctx, req.Config, path.Root("github_app").AtName("private_key_wo"), &resp.Diagnostics)).ValueString(),
 				},
 				{
 					Name:  "secure:gitHubApp.webhookSecret",
-					Value: plan.GithubApp.WebhookSecret.ValueString(),
+					Value: secretValue(plan.GithubApp.WebhookSecret, writeOnlyValue(ctx, req.Config, path.Root("github_app").AtName("webhook_secret_wo"), &resp.Diagnostics)).ValueString(),
 				},
 			},
-		},
+		}
+	}
+
+	if plan.Slack != nil {
+		feature.Properties = models.Properties{
+			Property: []models.Property{
+				{
+					Name:  "providerType",
+					Value: "slackConnection",
+				},
+				{
+					Name:  "displayName",
+					Value: plan.Slack.DisplayName.ValueString(),
+				},
+				{
+					Name:  "clientId",
+					Value: plan.Slack.ClientId.ValueString(),
+				},
+				{
+					Name:  "secure:clientSecret",
+					Value: secretValue(plan.Slack.ClientSecret, writeOnlyValue(ctx, req.Config, path.Root("slack").AtName("client_secret_wo"), &resp.Diagnostics)).ValueString(),
+				},
+				{
+					Name:  "secure:token",
+					Value: secretValue(plan.Slack.BotToken, writeOnlyValue(ctx, req.Config, path.Root("slack").AtName("bot_token_wo"), &resp.Diagnostics)).ValueString(),
+				},
+			},
+		}
 	}
 
 	result, err := r.client.NewProjectFeature(plan.ProjectId.ValueString(), feature)
@@ -224,46 +322,118 @@ func (r *connectionResource) Update(ctx context.Context, req resource.UpdateRequ
 	projectId := plan.ProjectId.ValueString()
 	featureId := plan.FeatureId.ValueString()
 
-	if result, ok := r.setFieldString(projectId, featureId, "displayName", oldState.GithubApp.DisplayName, plan.GithubApp.DisplayName, &resp.Diagnostics); ok {
-		newState.GithubApp.DisplayName = result
-	} else {
-		return
-	}
-
-	if result, ok := r.setFieldString(projectId, featureId, "gitHubApp.ownerUrl", oldState.GithubApp.OwnerUrl, plan.GithubApp.OwnerUrl, &resp.Diagnostics); ok {
-		newState.GithubApp.OwnerUrl = result
-	} else {
-		return
+	if plan.GithubApp != nil {
+		newState.GithubApp = &GithubApp{}
+
+		if result, ok := r.setFieldString(projectId, featureId, "displayName", oldState.GithubApp.DisplayName, plan.GithubApp.DisplayName, &resp.Diagnostics); ok {
+			newState.GithubApp.DisplayName = result
+		} else {
+			return
+		}
+
+		if result, ok := r.setFieldString(projectId, featureId, "gitHubApp.ownerUrl", oldState.GithubApp.OwnerUrl, plan.GithubApp.OwnerUrl, &resp.Diagnostics); ok {
+			newState.GithubApp.OwnerUrl = result
+		} else {
+			return
+		}
+
+		if result, ok := r.setFieldString(projectId, featureId, "gitHubApp.appId", oldState.GithubApp.AppId, plan.GithubApp.AppId, &resp.Diagnostics); ok {
+			newState.GithubApp.AppId = result
+		} else {
+			return
+		}
+
+		if result, ok := r.setFieldString(projectId, featureId, "gitHubApp.clientId", oldState.GithubApp.ClientId, plan.GithubApp.ClientId, &resp.Diagnostics); ok {
+			newState.GithubApp.ClientId = result
+		} else {
+			return
+		}
+
+		if result, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.clientSecret", oldState.GithubApp.ClientSecret, plan.GithubApp.ClientSecret, &resp.Diagnostics); ok {
+			newState.GithubApp.ClientSecret = result
+		} else {
+			return
+		}
+
+		if writeOnlyChanged(plan.GithubApp.ClientSecretVersion, oldState.GithubApp.ClientSecretVersion, plan.GithubApp.ClientSecret, oldState.GithubApp.ClientSecret) {
+			value := writeOnlyValue(ctx, req.Config, path.Root("github_app").AtName("client_secret_wo"), &resp.Diagnostics)
+			if _, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.clientSecret", types.StringNull(), value, &resp.Diagnostics); !ok {
+				return
+			}
+		}
+		newState.GithubApp.ClientSecretVersion = plan.GithubApp.ClientSecretVersion
+
+		if result, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.privateKey", oldState.GithubApp.PrivateKey, plan.GithubApp.PrivateKey, &resp.Diagnostics); ok {
+			newState.GithubApp.PrivateKey = result
+		} else {
+			return
+		}
+
+		if writeOnlyChanged(plan.GithubApp.PrivateKeyVersion, oldState.GithubApp.PrivateKeyVersion, plan.GithubApp.PrivateKey, oldState.GithubApp.PrivateKey) {
+			value := writeOnlyValue(ctx, req.Config, path.Root("github_app").AtName("private_key_wo"), &resp.Diagnostics)
+			if _, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.privateKey", types.StringNull(), value, &resp.Diagnostics); !ok {
+				return
+			}
+		}
+		newState.GithubApp.PrivateKeyVersion = plan.GithubApp.PrivateKeyVersion
+
+		if result, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.webhookSecret", oldState.GithubApp.WebhookSecret, plan.GithubApp.WebhookSecret, &resp.Diagnostics); ok {
+			newState.GithubApp.WebhookSecret = result
+		} else {
+			return
+		}
+
+		if writeOnlyChanged(plan.GithubApp.WebhookSecretVersion, oldState.GithubApp.WebhookSecretVersion, plan.GithubApp.WebhookSecret, oldState.GithubApp.WebhookSecret) {
+			value := writeOnlyValue(ctx, req.Config, path.Root("github_app").AtName("webhook_secret_wo"), &resp.Diagnostics)
+			if _, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.webhookSecret", types.StringNull(), value, &resp.Diagnostics); !ok {
+				return
+			}
+		}
+		newState.GithubApp.WebhookSecretVersion = plan.GithubApp.WebhookSecretVersion
 	}
 
-	if result, ok := r.setFieldString(projectId, featureId, "gitHubApp.appId", oldState.GithubApp.AppId, plan.GithubApp.AppId, &resp.Diagnostics); ok {
-		newState.GithubApp.AppId = result
-	} else {
-		return
-	}
-
-	if result, ok := r.setFieldString(projectId, featureId, "gitHubApp.clientId", oldState.GithubApp.ClientId, plan.GithubApp.ClientId, &resp.Diagnostics); ok {
-		newState.GithubApp.ClientId = result
-	} else {
-		return
-	}
-
-	if result, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.clientSecret", oldState.GithubApp.ClientSecret, plan.GithubApp.ClientSecret, &resp.Diagnostics); ok {
-		newState.GithubApp.ClientSecret = result
-	} else {
-		return
-	}
-
-	if result, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.privateKey", oldState.GithubApp.PrivateKey, plan.GithubApp.PrivateKey, &resp.Diagnostics); ok {
-		newState.GithubApp.PrivateKey = result
-	} else {
-		return
-	}
-
-	if result, ok := r.setFieldString(projectId, featureId, "secure:gitHubApp.webhookSecret", oldState.GithubApp.WebhookSecret, plan.GithubApp.WebhookSecret, &resp.Diagnostics); ok {
-		newState.GithubApp.WebhookSecret = result
-	} else {
-		return
+	if plan.Slack != nil {
+		newState.Slack = &Slack{}
+
+		if result, ok := r.setFieldString(projectId, featureId, "displayName", oldState.Slack.DisplayName, plan.Slack.DisplayName, &resp.Diagnostics); ok {
+			newState.Slack.DisplayName = result
+		} else {
+			return
+		}
+
+		if result, ok := r.setFieldString(projectId, featureId, "clientId", oldState.Slack.ClientId, plan.Slack.ClientId, &resp.Diagnostics); ok {
+			newState.Slack.ClientId = result
+		} else {
+			return
+		}
+
+		if result, ok := r.setFieldString(projectId, featureId, "secure:clientSecret", oldState.Slack.ClientSecret, plan.Slack.ClientSecret, &resp.Diagnostics); ok {
+			newState.Slack.ClientSecret = result
+		} else {
+			return
+		}
+
+		if writeOnlyChanged(plan.Slack.ClientSecretVersion, oldState.Slack.ClientSecretVersion, plan.Slack.ClientSecret, oldState.Slack.ClientSecret) {
+			value := writeOnlyValue(ctx, req.Config, path.Root("slack").AtName("client_secret_wo"), &resp.Diagnostics)
+			if _, ok := r.setFieldString(projectId, featureId, "secure:clientSecret", types.StringNull(), value, &resp.Diagnostics); !ok {
+				return
+			}
+		}
+		newState.Slack.ClientSecretVersion = plan.Slack.ClientSecretVersion
+
+		if result, ok := r.setFieldString(projectId, featureId, "secure:token", oldState.Slack.BotToken, plan.Slack.BotToken, &resp.Diagnostics); ok {
+			newState.Slack.BotToken = result
+		} else {
+			return
+		}
+
+		if writeOnlyChanged(plan.Slack.BotTokenVersion, oldState.Slack.BotTokenVersion, plan.Slack.BotToken, oldState.Slack.BotToken) {
+			value := writeOnlyValue(ctx, req.Config, path.Root("slack").AtName("bot_token_wo"), &resp.Diagnostics)
+			if _, ok := r.setFieldString(projectId, featureId, "secure:token", types.StringNull(), value, &resp.Diagnostics); !ok {
+				return
+			}
+		}
+		newState.Slack.BotTokenVersion = plan.Slack.BotTokenVersion
 	}
 
 	diags = resp.State.Set(ctx, newState)
@@ -291,6 +461,16 @@ func (r *connectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *connectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid connection import ID", "Use <project_id>/<feature_id>.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature_id"), parts[1])...)
+}
+
 func (r *connectionResource) readState(result models.ProjectFeatureJson, plan connectionResourceModel) connectionResourceModel {
 	props := make(map[string]string)
 	for _, p := range result.Properties.Property {
@@ -300,18 +480,42 @@ func (r *connectionResource) readState(result models.ProjectFeatureJson, plan co
 	var newState connectionResourceModel
 	newState.ProjectId = plan.ProjectId
 	newState.FeatureId = types.StringValue(*result.Id)
-	newState.GithubApp.DisplayName = types.StringValue(props["displayName"])
-	newState.GithubApp.OwnerUrl = types.StringValue(props["gitHubApp.ownerUrl"])
-	newState.GithubApp.AppId = types.StringValue(props["gitHubApp.appId"])
-	newState.GithubApp.ClientId = types.StringValue(props["gitHubApp.clientId"])
-	if _, ok := props["secure:gitHubApp.clientSecret"]; ok {
-		newState.GithubApp.ClientSecret = plan.GithubApp.ClientSecret
-	}
-	if _, ok := props["secure:gitHubApp.privateKey"]; ok {
-		newState.GithubApp.PrivateKey = plan.GithubApp.PrivateKey
-	}
-	if _, ok := props["secure:gitHubApp.webhookSecret"]; ok {
-		newState.GithubApp.WebhookSecret = plan.GithubApp.WebhookSecret
+
+	switch props["providerType"] {
+	case "GitHubApp":
+		newState.GithubApp = &GithubApp{}
+		newState.GithubApp.DisplayName = types.StringValue(props["displayName"])
+		newState.GithubApp.OwnerUrl = types.StringValue(props["gitHubApp.ownerUrl"])
+		newState.GithubApp.AppId = types.StringValue(props["gitHubApp.appId"])
+		newState.GithubApp.ClientId = types.StringValue(props["gitHubApp.clientId"])
+		if plan.GithubApp != nil {
+			if _, ok := props["secure:gitHubApp.clientSecret"]; ok {
+				newState.GithubApp.ClientSecret = plan.GithubApp.ClientSecret
+			}
+			newState.GithubApp.ClientSecretVersion = plan.GithubApp.ClientSecretVersion
+			if _, ok := props["secure:gitHubApp.privateKey"]; ok {
+				newState.GithubApp.PrivateKey = plan.GithubApp.PrivateKey
+			}
+			newState.GithubApp.PrivateKeyVersion = plan.GithubApp.PrivateKeyVersion
+			if _, ok := props["secure:gitHubApp.webhookSecret"]; ok {
+				newState.GithubApp.WebhookSecret = plan.GithubApp.WebhookSecret
+			}
+			newState.GithubApp.WebhookSecretVersion = plan.GithubApp.WebhookSecretVersion
+		}
+	case "slackConnection":
+		newState.Slack = &Slack{}
+		newState.Slack.DisplayName = types.StringValue(props["displayName"])
+		newState.Slack.ClientId = types.StringValue(props["clientId"])
+		if plan.Slack != nil {
+			if _, ok := props["secure:clientSecret"]; ok {
+				newState.Slack.ClientSecret = plan.Slack.ClientSecret
+			}
+			newState.Slack.ClientSecretVersion = plan.Slack.ClientSecretVersion
+			if _, ok := props["secure:token"]; ok {
+				newState.Slack.BotToken = plan.Slack.BotToken
+			}
+			newState.Slack.BotTokenVersion = plan.Slack.BotTokenVersion
+		}
 	}
 	return newState
 }
@@ -345,3 +549,17 @@ func (r *connectionResource) setFieldString(projectId, featureId, name string, s
 
 	return types.StringValue(result), true
 }
+
+// blockTypeRequiresReplace forces a new resource when switching between the
+// type blocks of a resource, e.g. the provider type of an existing connection
+// or the type of an existing build feature cannot be changed.
+func blockTypeRequiresReplace(what string) planmodifier.Object {
+	description := "Changing the " + what + " type requires a new " + what + "."
+	return objectplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+		},
+		description,
+		description,
+	)
+}
@@ -0,0 +1,61 @@
+package teamcity
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccConnection_slack(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccConnectionSlackConfig("Slack"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("teamcity_connection.test", "feature_id"),
+					resource.TestCheckResourceAttr("teamcity_connection.test", "project_id", "_Root"),
+					resource.TestCheckResourceAttr("teamcity_connection.test", "slack.display_name", "Slack"),
+					resource.TestCheckResourceAttr("teamcity_connection.test", "slack.client_id", "1234567890.1234567890"),
+					resource.TestCheckNoResourceAttr("teamcity_connection.test", "github_app"),
+				),
+			},
+			{
+				Config: providerConfig + testAccConnectionSlackConfig("Slack notifications"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_connection.test", "slack.display_name", "Slack notifications"),
+					resource.TestCheckResourceAttr("teamcity_connection.test", "slack.client_secret", "client-secret"),
+				),
+			},
+			{
+				ResourceName:                         "teamcity_connection.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "feature_id",
+				ImportStateVerifyIgnore:              []string{"slack.client_secret", "slack.bot_token"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["teamcity_connection.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					return rs.Primary.Attributes["project_id"] + "/" + rs.Primary.Attributes["feature_id"], nil
+				},
+			},
+		},
+	})
+}
+
+func testAccConnectionSlackConfig(displayName string) string {
+	return fmt.Sprintf(`
+        resource "teamcity_connection" "test" {
+            project_id = "_Root"
+            slack = {
+                display_name  = %q
+                client_id     = "1234567890.1234567890"
+                client_secret = "client-secret"
+                bot_token     = "xoxb-bot-token"
+            }
+        }
+    `, displayName)
+}
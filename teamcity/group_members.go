@@ -0,0 +1,250 @@
+package teamcity
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &membersResource{}
+	_ resource.ResourceWithConfigure   = &membersResource{}
+	_ resource.ResourceWithImportState = &membersResource{}
+)
+
+func NewMembersResource() resource.Resource {
+	return &membersResource{}
+}
+
+type membersResource struct {
+	client *client.Client
+}
+
+type membersResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	GroupId       types.String `tfsdk:"group_id"`
+	Usernames     types.Set    `tfsdk:"usernames"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+}
+
+func (r *membersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (r *membersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the members of a group. With `authoritative` enabled, members not listed in `usernames` are removed from the group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource identifier (same as group_id).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"usernames": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Usernames of the group members.",
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Remove the members of the group not listed in `usernames`. When disabled, only the listed members are managed. Default `false`.",
+			},
+		},
+	}
+}
+
+func (r *membersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *membersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan membersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.GroupId
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *membersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state membersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.GetGroupMembers(state.GroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading group members",
+			err.Error(),
+		)
+		return
+	}
+
+	if members == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Without authoritative mode only the managed members are tracked,
+	// after import all members are managed.
+	if !state.Authoritative.ValueBool() && !state.Usernames.IsNull() {
+		managed := setStrings(state.Usernames)
+		var present []string
+		for _, m := range members {
+			if slices.Contains(managed, m) {
+				present = append(present, m)
+			}
+		}
+		members = present
+	}
+
+	usernames, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = state.GroupId
+	state.Usernames = usernames
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *membersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan membersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state membersResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, plan, setStrings(state.Usernames), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.GroupId
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *membersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state membersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, username := range setStrings(state.Usernames) {
+		err := r.client.DeleteGroupMember(state.GroupId.ValueString(), username)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting group member "+username,
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *membersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group_id"), req, resp)
+}
+
+// reconcile adds the planned members missing in the group and removes the
+// unexpected ones: all of them in authoritative mode, otherwise only the
+// previously managed members.
+func (r *membersResource) reconcile(_ context.Context, plan membersResourceModel, managed []string, diags *diag.Diagnostics) {
+	groupId := plan.GroupId.ValueString()
+
+	members, err := r.client.GetGroupMembers(groupId)
+	if err != nil {
+		diags.AddError("Error reading group members", err.Error())
+		return
+	}
+	if members == nil {
+		diags.AddError("Group not found", "The group '"+groupId+"' was not found")
+		return
+	}
+
+	planned := setStrings(plan.Usernames)
+	for _, username := range planned {
+		if slices.Contains(members, username) {
+			continue
+		}
+		if err := r.client.AddGroupMember(groupId, username); err != nil {
+			diags.AddError("Error adding group member "+username, err.Error())
+			return
+		}
+	}
+
+	candidates := managed
+	if plan.Authoritative.ValueBool() {
+		candidates = members
+	}
+	for _, username := range candidates {
+		if slices.Contains(planned, username) || !slices.Contains(members, username) {
+			continue
+		}
+		if err := r.client.DeleteGroupMember(groupId, username); err != nil {
+			diags.AddError("Error removing group member "+username, err.Error())
+			return
+		}
+	}
+}
+
+func setStrings(set types.Set) []string {
+	var values []string
+	for _, v := range set.Elements() {
+		values = append(values, v.(types.String).ValueString())
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,142 @@
+package teamcity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccGroupMembersBase = `
+resource "teamcity_group" "test" {
+  name = "test_members_group"
+}
+
+resource "teamcity_user" "a" {
+  username = "test_members_a"
+}
+
+resource "teamcity_user" "b" {
+  username = "test_members_b"
+}
+
+resource "teamcity_user" "stray" {
+  username = "test_members_stray"
+}
+
+resource "teamcity_group_member" "stray" {
+  group_id = teamcity_group.test.id
+  username = teamcity_user.stray.username
+}
+`
+
+func TestAccGroupMembersResource_additive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccGroupMembersBase + `
+resource "teamcity_group_members" "test" {
+  group_id  = teamcity_group.test.id
+  usernames = [teamcity_user.a.username, teamcity_user.b.username]
+
+  depends_on = [teamcity_group_member.stray]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_group_members.test", "authoritative", "false"),
+					resource.TestCheckResourceAttr("teamcity_group_members.test", "usernames.#", "2"),
+				),
+			},
+			{
+				// The stray member is kept, so the plan stays empty
+				Config: providerConfig + testAccGroupMembersBase + `
+resource "teamcity_group_members" "test" {
+  group_id  = teamcity_group.test.id
+  usernames = [teamcity_user.a.username]
+
+  depends_on = [teamcity_group_member.stray]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_group_members.test", "usernames.#", "1"),
+					resource.TestCheckResourceAttr("teamcity_group_member.stray", "username", "test_members_stray"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupMembersResource_authoritative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_group" "test" {
+  name = "test_auth_members_group"
+}
+
+resource "teamcity_user" "a" {
+  username = "test_auth_members_a"
+}
+
+resource "teamcity_user" "stray" {
+  username = "test_auth_members_stray"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(_ *terraform.State) error {
+						c := testAccClientFromEnv()
+						return c.AddGroupMember("TESTAUTHMEMBERSGROUP", "test_auth_members_stray")
+					},
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_group" "test" {
+  name = "test_auth_members_group"
+}
+
+resource "teamcity_user" "a" {
+  username = "test_auth_members_a"
+}
+
+resource "teamcity_user" "stray" {
+  username = "test_auth_members_stray"
+}
+
+resource "teamcity_group_members" "test" {
+  group_id      = teamcity_group.test.id
+  usernames     = [teamcity_user.a.username]
+  authoritative = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_group_members.test", "usernames.#", "1"),
+					resource.TestCheckTypeSetElemAttr("teamcity_group_members.test", "usernames.*", "test_auth_members_a"),
+					func(_ *terraform.State) error {
+						c := testAccClientFromEnv()
+						ok, err := c.CheckGroupMember("TESTAUTHMEMBERSGROUP", "test_auth_members_stray")
+						if err != nil {
+							return err
+						}
+						if ok {
+							return fmt.Errorf("stray member was not removed")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         "teamcity_group_members.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "TESTAUTHMEMBERSGROUP",
+				ImportStateVerifyIdentifierAttribute: "group_id",
+				ImportStateVerifyIgnore:              []string{"authoritative"},
+			},
+		},
+	})
+}
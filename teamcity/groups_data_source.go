@@ -0,0 +1,164 @@
+package teamcity
+
+import (
+	"context"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &groupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupsDataSource{}
+)
+
+type groupsDataSource struct {
+	client *client.Client
+}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+type groupsDataSourceModel struct {
+	Groups []groupsDataGroupModel `tfsdk:"groups"`
+}
+
+type groupsDataGroupModel struct {
+	Key          types.String                          `tfsdk:"key"`
+	Name         types.String                          `tfsdk:"name"`
+	Description  types.String                          `tfsdk:"description"`
+	ParentGroups []types.String                        `tfsdk:"parent_groups"`
+	ChildGroups  []types.String                        `tfsdk:"child_groups"`
+	Members      []types.String                        `tfsdk:"members"`
+	Roles        []models.RoleAssignmentGroupDataModel `tfsdk:"roles"`
+}
+
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get all TeamCity groups with their hierarchy and members.",
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The key (identifier) of the group.",
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"parent_groups": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Keys of the parent groups.",
+						},
+						"child_groups": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Keys of the child groups.",
+						},
+						"members": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Usernames of the direct members of the group.",
+						},
+						"roles": schema.SetNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed: true,
+									},
+									"global": schema.BoolAttribute{
+										Computed: true,
+									},
+									"project": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *groupsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	groups, err := d.client.GetGroups()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading groups",
+			err.Error(),
+		)
+		return
+	}
+
+	state := groupsDataSourceModel{
+		Groups: []groupsDataGroupModel{},
+	}
+	for _, g := range groups {
+		group := groupsDataGroupModel{
+			Key:          types.StringValue(g.Key),
+			Name:         types.StringValue(g.Name),
+			Description:  types.StringValue(g.Description),
+			ParentGroups: groupKeys(g.Parents),
+			ChildGroups:  groupKeys(g.Children),
+			Members:      []types.String{},
+			Roles:        []models.RoleAssignmentGroupDataModel{},
+		}
+		if g.Users != nil {
+			for _, u := range g.Users.User {
+				group.Members = append(group.Members, types.StringValue(u.Username))
+			}
+		}
+		if g.Roles != nil {
+			for _, role := range g.Roles.RoleAssignment {
+				assignment := models.RoleAssignmentGroupDataModel{
+					Id: types.StringValue(role.Id),
+				}
+				if role.Scope == "g" {
+					assignment.Global = types.BoolValue(true)
+				} else {
+					assignment.Global = types.BoolValue(false)
+					assignment.Project = types.StringValue(role.Scope[2:])
+				}
+				group.Roles = append(group.Roles, assignment)
+			}
+		}
+		state.Groups = append(state.Groups, group)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func groupKeys(groups *models.ParentGroupsJson) []types.String {
+	keys := []types.String{}
+	if groups != nil {
+		for _, g := range groups.Group {
+			keys = append(keys, types.StringValue(g.Key))
+		}
+	}
+	return keys
+}
@@ -0,0 +1,206 @@
+package teamcity
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-teamcity/client"
+)
+
+var (
+	_ resource.Resource                = &internalPropertyResource{}
+	_ resource.ResourceWithConfigure   = &internalPropertyResource{}
+	_ resource.ResourceWithImportState = &internalPropertyResource{}
+)
+
+type internalPropertyResource struct {
+	client *client.Client
+}
+
+func NewInternalPropertyResource() resource.Resource {
+	return &internalPropertyResource{}
+}
+
+type internalPropertyResourceModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *internalPropertyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_property"
+}
+
+func (r *internalPropertyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An [internal property](https://www.jetbrains.com/help/teamcity/server-startup-properties.html#TeamCity+Internal+Properties) of the server, as set in the `internal.properties` file. " +
+			"Requires a server that manages internal properties through the REST API. Destroying the resource removes the property, the server uses its default value then.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the property, e.g. `teamcity.vcs.checkInterval`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *internalPropertyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *internalPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan internalPropertyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkInternalPropertiesAvailable(r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.set(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *internalPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState internalPropertyResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, err := r.client.GetInternalProperty(oldState.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading internal property",
+			err.Error(),
+		)
+		return
+	}
+	if value == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := internalPropertyResourceModel{
+		Name:  oldState.Name,
+		Value: types.StringValue(*value),
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *internalPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan internalPropertyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.set(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *internalPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state internalPropertyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteInternalProperty(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting internal property",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *internalPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (r *internalPropertyResource) set(plan internalPropertyResourceModel, diags *diag.Diagnostics) internalPropertyResourceModel {
+	name := plan.Name.ValueString()
+	if err := r.client.SetInternalProperty(name, plan.Value.ValueString()); err != nil {
+		diags.AddError(
+			"Error setting internal property "+name,
+			err.Error(),
+		)
+		return plan
+	}
+
+	value, err := r.client.GetInternalProperty(name)
+	if err != nil {
+		diags.AddError(
+			"Error reading internal property "+name,
+			err.Error(),
+		)
+		return plan
+	}
+	if value == nil {
+		diags.AddError(
+			"Error reading internal property "+name,
+			"The property is not set after setting it",
+		)
+		return plan
+	}
+
+	return internalPropertyResourceModel{
+		Name:  plan.Name,
+		Value: types.StringValue(*value),
+	}
+}
+
+// checkInternalPropertiesAvailable fails when the server has no REST endpoint
+// for internal properties, they have to be set in the internal.properties
+// file of the server then.
+func checkInternalPropertiesAvailable(c *client.Client, diags *diag.Diagnostics) {
+	available, err := c.InternalPropertiesAvailable()
+	if err != nil {
+		diags.AddError(
+			"Error reading internal properties",
+			err.Error(),
+		)
+		return
+	}
+	if !available {
+		diags.AddError(
+			"Internal properties not available",
+			"The server doesn't provide the /app/rest/server/internalProperties endpoint. "+
+				"Set the properties in the internal.properties file of the server or with -D<name>=<value> in TEAMCITY_SERVER_OPTS instead.",
+		)
+	}
+}
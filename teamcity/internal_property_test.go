@@ -0,0 +1,42 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccInternalProperty_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_internal_property" "test" {
+	name  = "teamcity.acceptance.test"
+	value = "one"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_internal_property.test", "name", "teamcity.acceptance.test"),
+					resource.TestCheckResourceAttr("teamcity_internal_property.test", "value", "one"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_internal_property" "test" {
+	name  = "teamcity.acceptance.test"
+	value = "two"
+}
+`,
+				Check: resource.TestCheckResourceAttr("teamcity_internal_property.test", "value", "two"),
+			},
+			{
+				ResourceName:                         "teamcity_internal_property.test",
+				ImportState:                          true,
+				ImportStateId:                        "teamcity.acceptance.test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
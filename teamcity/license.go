@@ -2,6 +2,7 @@ package teamcity
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
 )
 
 var (
@@ -30,21 +32,65 @@ func (r *licenseResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 type licenseResourceModel struct {
-	Key types.String `tfsdk:"key"`
+	Key                          types.String `tfsdk:"key"`
+	Type                         types.String `tfsdk:"type"`
+	Valid                        types.Bool   `tfsdk:"valid"`
+	Active                       types.Bool   `tfsdk:"active"`
+	Expired                      types.Bool   `tfsdk:"expired"`
+	ExpirationDate               types.String `tfsdk:"expiration_date"`
+	MaintenanceEndDate           types.String `tfsdk:"maintenance_end_date"`
+	Agents                       types.Int64  `tfsdk:"agents"`
+	UnlimitedAgents              types.Bool   `tfsdk:"unlimited_agents"`
+	BuildConfigurations          types.Int64  `tfsdk:"build_configurations"`
+	UnlimitedBuildConfigurations types.Bool   `tfsdk:"unlimited_build_configurations"`
+	ErrorDetails                 types.String `tfsdk:"error_details"`
+}
+
+// licenseDetails are the computed attributes of a license key, shared by the
+// teamcity_license resource and the teamcity_licenses data source.
+var licenseDetails = []struct {
+	name        string
+	attrType    attr.Type
+	description string
+}{
+	{"type", types.StringType, "Type of the license, e.g. `commercial` or `evaluation`."},
+	{"valid", types.BoolType, "Whether the server accepts the license key."},
+	{"active", types.BoolType, "Whether the license is in use."},
+	{"expired", types.BoolType, ""},
+	{"expiration_date", types.StringType, "Expiration date of the license in RFC 3339 format, null for a perpetual license."},
+	{"maintenance_end_date", types.StringType, "End of the maintenance period in RFC 3339 format, newer server versions released after it are not covered by the license."},
+	{"agents", types.Int64Type, "Number of agents added by the license, null when unlimited."},
+	{"unlimited_agents", types.BoolType, ""},
+	{"build_configurations", types.Int64Type, "Number of build configurations added by the license, null when unlimited."},
+	{"unlimited_build_configurations", types.BoolType, ""},
+	{"error_details", types.StringType, "Why the license is not valid."},
 }
 
 func (r *licenseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"key": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+	attributes := map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Required:  true,
+			Sensitive: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
 		},
 	}
+	for _, detail := range licenseDetails {
+		switch detail.attrType {
+		case types.StringType:
+			attributes[detail.name] = schema.StringAttribute{Computed: true, Description: detail.description}
+		case types.BoolType:
+			attributes[detail.name] = schema.BoolAttribute{Computed: true, Description: detail.description}
+		case types.Int64Type:
+			attributes[detail.name] = schema.Int64Attribute{Computed: true, Description: detail.description}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Installs a [license key](https://www.jetbrains.com/help/teamcity/licensing-policy.html) on the server and exposes its details.",
+		Attributes:  attributes,
+	}
 }
 
 func (r *licenseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -71,8 +117,23 @@ func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	var newState licenseResourceModel
-	newState.Key = plan.Key
+	actual, err := r.client.GetLicense(plan.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading license key",
+			err.Error(),
+		)
+		return
+	}
+	if actual == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading license key",
+			"The license key is not listed by the server after adding it",
+		)
+		return
+	}
+
+	newState := licenseModel(plan.Key.ValueString(), actual)
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -89,7 +150,7 @@ func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	ok, err := r.client.CheckLicense(oldState.Key.ValueString())
+	actual, err := r.client.GetLicense(oldState.Key.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading license key",
@@ -98,13 +159,12 @@ func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	if ok == false {
+	if actual == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	var newState licenseResourceModel
-	newState.Key = oldState.Key
+	newState := licenseModel(oldState.Key.ValueString(), actual)
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -137,3 +197,38 @@ func (r *licenseResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *licenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
 }
+
+// licenseModel maps a license key of the server to the model. The key of the
+// configuration is kept, the server may format it differently.
+func licenseModel(key string, actual *models.LicenseKeyJson) licenseResourceModel {
+	model := licenseResourceModel{
+		Key:                          types.StringValue(key),
+		Type:                         types.StringValue(actual.Type),
+		Valid:                        types.BoolValue(actual.Valid),
+		Active:                       types.BoolValue(actual.Active),
+		Expired:                      types.BoolValue(actual.Expired),
+		ExpirationDate:               types.StringNull(),
+		MaintenanceEndDate:           types.StringNull(),
+		Agents:                       types.Int64Null(),
+		UnlimitedAgents:              types.BoolValue(actual.UnlimitedAgents),
+		BuildConfigurations:          types.Int64Null(),
+		UnlimitedBuildConfigurations: types.BoolValue(actual.UnlimitedBuildTypes),
+		ErrorDetails:                 types.StringNull(),
+	}
+	if actual.ExpirationDate != "" {
+		model.ExpirationDate = types.StringValue(teamcityTime(actual.ExpirationDate))
+	}
+	if actual.MaintenanceEndDate != "" {
+		model.MaintenanceEndDate = types.StringValue(teamcityTime(actual.MaintenanceEndDate))
+	}
+	if !actual.UnlimitedAgents {
+		model.Agents = types.Int64Value(actual.Agents)
+	}
+	if !actual.UnlimitedBuildTypes {
+		model.BuildConfigurations = types.Int64Value(actual.BuildTypes)
+	}
+	if actual.ErrorDetails != "" {
+		model.ErrorDetails = types.StringValue(actual.ErrorDetails)
+	}
+	return model
+}
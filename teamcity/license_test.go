@@ -0,0 +1,62 @@
+package teamcity
+
+import (
+	"terraform-provider-teamcity/models"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLicensesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "teamcity_licenses" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teamcity_licenses.all", "licenses.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestLicenseModel(t *testing.T) {
+	t.Run("limited license", func(t *testing.T) {
+		model := licenseModel("key", &models.LicenseKeyJson{
+			Type:               "commercial",
+			Valid:              true,
+			ExpirationDate:     "20261118T000000+0000",
+			MaintenanceEndDate: "20261118T000000+0000",
+			Agents:             10,
+			BuildTypes:         100,
+		})
+		if model.ExpirationDate != types.StringValue("2026-11-18T00:00:00Z") {
+			t.Fatalf("unexpected expiration date: %s", model.ExpirationDate)
+		}
+		if model.Agents != types.Int64Value(10) || model.BuildConfigurations != types.Int64Value(100) {
+			t.Fatalf("unexpected limits: %s, %s", model.Agents, model.BuildConfigurations)
+		}
+		if !model.ErrorDetails.IsNull() {
+			t.Fatalf("expected no error details, got %s", model.ErrorDetails)
+		}
+	})
+
+	t.Run("perpetual unlimited license", func(t *testing.T) {
+		model := licenseModel("key", &models.LicenseKeyJson{
+			Type:                "enterprise",
+			UnlimitedAgents:     true,
+			UnlimitedBuildTypes: true,
+			ErrorDetails:        "obsolete",
+		})
+		if !model.ExpirationDate.IsNull() || !model.Agents.IsNull() || !model.BuildConfigurations.IsNull() {
+			t.Fatalf("expected null expiration and limits: %#v", model)
+		}
+		if model.ErrorDetails != types.StringValue("obsolete") {
+			t.Fatalf("unexpected error details: %s", model.ErrorDetails)
+		}
+	})
+}
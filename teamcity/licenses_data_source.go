@@ -0,0 +1,89 @@
+package teamcity
+
+import (
+	"context"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &licensesDataSource{}
+	_ datasource.DataSourceWithConfigure = &licensesDataSource{}
+)
+
+type licensesDataSource struct {
+	client *client.Client
+}
+
+func NewLicensesDataSource() datasource.DataSource {
+	return &licensesDataSource{}
+}
+
+func (d *licensesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_licenses"
+}
+
+type licensesDataSourceModel struct {
+	Licenses []licenseResourceModel `tfsdk:"licenses"`
+}
+
+func (d *licensesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+	for _, detail := range licenseDetails {
+		switch detail.attrType {
+		case types.StringType:
+			attributes[detail.name] = schema.StringAttribute{Computed: true, Description: detail.description}
+		case types.BoolType:
+			attributes[detail.name] = schema.BoolAttribute{Computed: true, Description: detail.description}
+		case types.Int64Type:
+			attributes[detail.name] = schema.Int64Attribute{Computed: true, Description: detail.description}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the license keys installed on the server, e.g. to check their expiration dates.",
+		Attributes: map[string]schema.Attribute{
+			"licenses": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Installed license keys.",
+				NestedObject: schema.NestedAttributeObject{Attributes: attributes},
+			},
+		},
+	}
+}
+
+func (d *licensesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *licensesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	licenses, err := d.client.GetLicenses()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading license keys",
+			err.Error(),
+		)
+		return
+	}
+
+	state := licensesDataSourceModel{
+		Licenses: []licenseResourceModel{},
+	}
+	for i := range licenses {
+		state.Licenses = append(state.Licenses, licenseModel(licenses[i].Key, &licenses[i]))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,266 @@
+package teamcity
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &nodeResponsibilitiesResource{}
+	_ resource.ResourceWithConfigure   = &nodeResponsibilitiesResource{}
+	_ resource.ResourceWithImportState = &nodeResponsibilitiesResource{}
+)
+
+func NewNodeResponsibilitiesResource() resource.Resource {
+	return &nodeResponsibilitiesResource{}
+}
+
+type nodeResponsibilitiesResource struct {
+	client *client.Client
+}
+
+type nodeResponsibilitiesResourceModel struct {
+	NodeId           types.String `tfsdk:"node_id"`
+	Responsibilities types.Set    `tfsdk:"responsibilities"`
+	Role             types.String `tfsdk:"role"`
+	State            types.String `tfsdk:"state"`
+}
+
+func (r *nodeResponsibilitiesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_responsibilities"
+}
+
+func (r *nodeResponsibilitiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Responsibilities of a node in a [multi-node setup](https://www.jetbrains.com/help/teamcity/multinode-setup.html). " +
+			"All responsibilities of the node that are not listed are disabled. Destroying the resource keeps the responsibilities of the node unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"node_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the node, see the `teamcity_nodes` data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"responsibilities": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Enabled responsibilities, e.g. `MAIN_NODE`, `CAN_PROCESS_BUILD_MESSAGES`, `CAN_CHECK_FOR_CHANGES`, `CAN_PROCESS_BUILD_TRIGGERS` or `CAN_PROCESS_USER_DATA_MODIFICATION_REQUESTS`. " +
+					"The names available on the node are listed in the error when an unknown name is used.",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role of the node, `main_node` or `secondary_node`.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "State of the node, e.g. `online` or `offline`.",
+			},
+		},
+	}
+}
+
+func (r *nodeResponsibilitiesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *nodeResponsibilitiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan nodeResponsibilitiesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.apply(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *nodeResponsibilitiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState nodeResponsibilitiesResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, err := r.client.GetNode(oldState.NodeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading node",
+			err.Error(),
+		)
+		return
+	}
+	if node == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := readNodeResponsibilities(ctx, node, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *nodeResponsibilitiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan nodeResponsibilitiesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.apply(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete keeps the responsibilities, disabling them could leave the
+// cluster without a node processing builds.
+func (r *nodeResponsibilitiesResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *nodeResponsibilitiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node_id"), req, resp)
+}
+
+// apply enables the planned responsibilities of the node and disables the
+// others. Responsibilities are disabled first, e.g. to move MAIN_NODE.
+func (r *nodeResponsibilitiesResource) apply(ctx context.Context, plan nodeResponsibilitiesResourceModel, diags *diag.Diagnostics) nodeResponsibilitiesResourceModel {
+	id := plan.NodeId.ValueString()
+	node, err := r.client.GetNode(id)
+	if err != nil {
+		diags.AddError(
+			"Error reading node",
+			err.Error(),
+		)
+		return plan
+	}
+	if node == nil {
+		diags.AddAttributeError(
+			path.Root("node_id"),
+			"Node not found",
+			"There is no node with the ID '"+id+"'",
+		)
+		return plan
+	}
+
+	var planned []string
+	diags.Append(plan.Responsibilities.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return plan
+	}
+
+	enabled := responsibilityNames(node.EnabledResponsibilities)
+	disabled := responsibilityNames(node.DisabledResponsibilities)
+	available := append(append([]string{}, enabled...), disabled...)
+	sort.Strings(available)
+
+	wanted := map[string]bool{}
+	for _, name := range planned {
+		if !slices.Contains(available, name) {
+			diags.AddAttributeError(
+				path.Root("responsibilities"),
+				"Unknown responsibility",
+				"The node '"+id+"' has no responsibility '"+name+"', available: "+strings.Join(available, ", "),
+			)
+			continue
+		}
+		wanted[name] = true
+	}
+	if diags.HasError() {
+		return plan
+	}
+
+	for _, name := range enabled {
+		if !wanted[name] {
+			if err := r.client.SetNodeResponsibility(id, name, false); err != nil {
+				diags.AddError(
+					"Error disabling responsibility "+name,
+					err.Error(),
+				)
+				return plan
+			}
+		}
+	}
+	for _, name := range disabled {
+		if wanted[name] {
+			if err := r.client.SetNodeResponsibility(id, name, true); err != nil {
+				diags.AddError(
+					"Error enabling responsibility "+name,
+					err.Error(),
+				)
+				return plan
+			}
+		}
+	}
+
+	node, err = r.client.GetNode(id)
+	if err != nil {
+		diags.AddError(
+			"Error reading node",
+			err.Error(),
+		)
+		return plan
+	}
+	if node == nil {
+		diags.AddError(
+			"Error reading node",
+			"The node '"+id+"' disappeared while changing its responsibilities",
+		)
+		return plan
+	}
+
+	return readNodeResponsibilities(ctx, node, diags)
+}
+
+func readNodeResponsibilities(ctx context.Context, node *models.NodeJson, diags *diag.Diagnostics) nodeResponsibilitiesResourceModel {
+	responsibilities, d := types.SetValueFrom(ctx, types.StringType, responsibilityNames(node.EnabledResponsibilities))
+	diags.Append(d...)
+
+	return nodeResponsibilitiesResourceModel{
+		NodeId:           types.StringValue(node.Id),
+		Responsibilities: responsibilities,
+		Role:             types.StringValue(node.Role),
+		State:            types.StringValue(node.State),
+	}
+}
+
+func responsibilityNames(responsibilities *models.ResponsibilitiesJson) []string {
+	names := []string{}
+	if responsibilities == nil {
+		return names
+	}
+	for _, item := range responsibilities.Responsibility {
+		names = append(names, item.Name)
+	}
+	return names
+}
@@ -0,0 +1,84 @@
+package teamcity
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNodesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "teamcity_nodes" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.teamcity_nodes.all", "nodes.0.id"),
+					resource.TestCheckResourceAttr("data.teamcity_nodes.all", "nodes.0.role", "main_node"),
+				),
+			},
+		},
+	})
+}
+
+// The test server is a single node, its responsibilities are applied
+// unchanged to avoid disabling the main node.
+func TestAccNodeResponsibilities_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "teamcity_nodes" "all" {}
+
+resource "teamcity_node_responsibilities" "main" {
+	node_id          = data.teamcity_nodes.all.nodes[0].id
+	responsibilities = data.teamcity_nodes.all.nodes[0].enabled_responsibilities
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("teamcity_node_responsibilities.main", "node_id", "data.teamcity_nodes.all", "nodes.0.id"),
+					resource.TestCheckResourceAttrPair("teamcity_node_responsibilities.main", "responsibilities.#", "data.teamcity_nodes.all", "nodes.0.enabled_responsibilities.#"),
+					resource.TestCheckResourceAttr("teamcity_node_responsibilities.main", "role", "main_node"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:                         "teamcity_node_responsibilities.main",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "node_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["teamcity_node_responsibilities.main"]
+					if !ok {
+						return "", nil
+					}
+					return rs.Primary.Attributes["node_id"], nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccNodeResponsibilities_unknown(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "teamcity_nodes" "all" {}
+
+resource "teamcity_node_responsibilities" "main" {
+	node_id          = data.teamcity_nodes.all.nodes[0].id
+	responsibilities = ["NOT_A_RESPONSIBILITY"]
+}
+`,
+				ExpectError: regexp.MustCompile("Unknown responsibility"),
+			},
+		},
+	})
+}
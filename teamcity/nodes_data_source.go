@@ -0,0 +1,131 @@
+package teamcity
+
+import (
+	"context"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &nodesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodesDataSource{}
+)
+
+type nodesDataSource struct {
+	client *client.Client
+}
+
+func NewNodesDataSource() datasource.DataSource {
+	return &nodesDataSource{}
+}
+
+func (d *nodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+type nodesDataSourceModel struct {
+	Nodes []nodeDataModel `tfsdk:"nodes"`
+}
+
+type nodeDataModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Url                      types.String `tfsdk:"url"`
+	Role                     types.String `tfsdk:"role"`
+	State                    types.String `tfsdk:"state"`
+	Online                   types.Bool   `tfsdk:"online"`
+	Current                  types.Bool   `tfsdk:"current"`
+	EnabledResponsibilities  types.Set    `tfsdk:"enabled_responsibilities"`
+	DisabledResponsibilities types.Set    `tfsdk:"disabled_responsibilities"`
+}
+
+func (d *nodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the nodes of a [multi-node setup](https://www.jetbrains.com/help/teamcity/multinode-setup.html) with their states and responsibilities.",
+		Attributes: map[string]schema.Attribute{
+			"nodes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"url": schema.StringAttribute{
+							Computed: true,
+						},
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "Role of the node, `main_node` or `secondary_node`.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "State of the node, e.g. `online` or `offline`.",
+						},
+						"online": schema.BoolAttribute{
+							Computed: true,
+						},
+						"current": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the node serves the requests of the provider.",
+						},
+						"enabled_responsibilities": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"disabled_responsibilities": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *nodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *nodesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	nodes, err := d.client.GetNodes()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading nodes",
+			err.Error(),
+		)
+		return
+	}
+
+	state := nodesDataSourceModel{
+		Nodes: []nodeDataModel{},
+	}
+	for _, node := range nodes {
+		enabled, diags := types.SetValueFrom(ctx, types.StringType, responsibilityNames(node.EnabledResponsibilities))
+		resp.Diagnostics.Append(diags...)
+		disabled, diags := types.SetValueFrom(ctx, types.StringType, responsibilityNames(node.DisabledResponsibilities))
+		resp.Diagnostics.Append(diags...)
+
+		state.Nodes = append(state.Nodes, nodeDataModel{
+			Id:                       types.StringValue(node.Id),
+			Url:                      types.StringValue(node.Url),
+			Role:                     types.StringValue(node.Role),
+			State:                    types.StringValue(node.State),
+			Online:                   types.BoolValue(node.Online),
+			Current:                  types.BoolValue(node.Current),
+			EnabledResponsibilities:  enabled,
+			DisabledResponsibilities: disabled,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,487 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+)
+
+var (
+	_ resource.Resource                   = &notificationRuleResource{}
+	_ resource.ResourceWithConfigure      = &notificationRuleResource{}
+	_ resource.ResourceWithValidateConfig = &notificationRuleResource{}
+	_ resource.ResourceWithImportState    = &notificationRuleResource{}
+)
+
+// Notifier identifiers as used by TeamCity
+const (
+	emailNotifier = "email"
+	slackNotifier = "jbSlackNotifier"
+)
+
+// notificationEvents lists the supported events in the order of the TeamCity notification rule editor
+var notificationEvents = []string{
+	"build_started",
+	"build_failed_to_start",
+	"build_failed",
+	"first_failure",
+	"build_successful",
+	"build_fixed",
+	"build_hanging",
+	"investigation_assigned",
+	"investigation_updated",
+}
+
+func NewNotificationRuleResource() resource.Resource {
+	return &notificationRuleResource{}
+}
+
+type notificationRuleResource struct {
+	client *client.Client
+}
+
+type notificationRuleResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	UserId                types.String `tfsdk:"user_id"`
+	GroupId               types.String `tfsdk:"group_id"`
+	Notifier              types.String `tfsdk:"notifier"`
+	ProjectId             types.String `tfsdk:"project_id"`
+	BuildConfigurationIds types.Set    `tfsdk:"build_configuration_ids"`
+	BranchFilter          types.String `tfsdk:"branch_filter"`
+	Events                types.Set    `tfsdk:"events"`
+	SlackConnectionId     types.String `tfsdk:"slack_connection_id"`
+	SlackChannel          types.String `tfsdk:"slack_channel"`
+}
+
+func (r *notificationRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+func (r *notificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Notification rule of a user or a group, defining which events are sent and through which notifier (email or Slack). More info [here](https://www.jetbrains.com/help/teamcity/subscribing-to-notifications.html)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the user owning the rule. Conflicts with `group_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("user_id"),
+						path.MatchRoot("group_id"),
+					),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Key of the group owning the rule. Conflicts with `user_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notifier": schema.StringAttribute{
+				Required:    true,
+				Description: "Notifier used to deliver the notifications. Values: `email`, `slack`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"email", "slack"}...),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Watch builds of all build configurations in the project and its subprojects.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("project_id"),
+						path.MatchRoot("build_configuration_ids"),
+					),
+				},
+			},
+			"build_configuration_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Watch builds of the specified build configurations.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"branch_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Branch filter limiting the watched builds, e.g. `+:<default>`.",
+			},
+			"events": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Events to notify about. Values: `" + strings.Join(notificationEvents, "`, `") + "`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationEvents...)),
+				},
+			},
+			"slack_connection_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Feature ID of the Slack `teamcity_connection`. Required for the `slack` notifier.",
+			},
+			"slack_channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "Slack channel (e.g. `#builds`) or user ID receiving the messages. Required for the `slack` notifier.",
+			},
+		},
+	}
+}
+
+func (r *notificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config notificationRuleResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Notifier.IsUnknown() || config.Notifier.IsNull() {
+		return
+	}
+
+	if config.Notifier.ValueString() == "slack" {
+		if config.SlackConnectionId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slack_connection_id"),
+				"'slack_connection_id' must be specified for the 'slack' notifier",
+				"",
+			)
+		}
+		if config.SlackChannel.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slack_channel"),
+				"'slack_channel' must be specified for the 'slack' notifier",
+				"",
+			)
+		}
+	} else {
+		if !config.SlackConnectionId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slack_connection_id"),
+				"'slack_connection_id' can only be specified for the 'slack' notifier",
+				"",
+			)
+		}
+		if !config.SlackChannel.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slack_channel"),
+				"'slack_channel' can only be specified for the 'slack' notifier",
+				"",
+			)
+		}
+	}
+}
+
+func (r *notificationRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *notificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, ok := r.update(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	actual, err := r.client.NewNotificationRule(plan.endpoint(), rule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding notification rule",
+			err.Error(),
+		)
+		return
+	}
+	if actual.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error adding notification rule",
+			"The server returned no notification rule id",
+		)
+		return
+	}
+
+	newState := r.readState(*actual, plan)
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState notificationRuleResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actual, err := r.client.GetNotificationRule(oldState.endpoint(), oldState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notification rule",
+			err.Error(),
+		)
+		return
+	}
+
+	if actual == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := r.readState(*actual, oldState)
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *notificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, ok := r.update(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	actual, err := r.client.SetNotificationRule(plan.endpoint(), plan.Id.ValueString(), rule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating notification rule",
+			err.Error(),
+		)
+		return
+	}
+
+	newState := r.readState(*actual, plan)
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *notificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationRule(state.endpoint(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting notification rule",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *notificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	var owner []string
+	if len(idParts) == 2 {
+		owner = strings.SplitN(idParts[0], ":", 2)
+	}
+
+	if len(owner) != 2 || (owner[0] != "user" && owner[0] != "group") || owner[1] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user:<user_id>/<rule_id> or group:<group_id>/<rule_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(owner[0]+"_id"), owner[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (m notificationRuleResourceModel) endpoint() string {
+	if !m.UserId.IsNull() {
+		return client.UserNotificationRules(m.UserId.ValueString())
+	}
+	return client.GroupNotificationRules(m.GroupId.ValueString())
+}
+
+func (r *notificationRuleResource) update(ctx context.Context, plan notificationRuleResourceModel, diags *diag.Diagnostics) (models.NotificationRuleJson, bool) {
+	rule := models.NotificationRuleJson{
+		Notifier: emailNotifier,
+	}
+
+	if plan.Notifier.ValueString() == "slack" {
+		rule.Notifier = slackNotifier
+		rule.Properties = &models.Properties{
+			Property: []models.Property{
+				{
+					Name:  "connection",
+					Value: plan.SlackConnectionId.ValueString(),
+				},
+				{
+					Name:  "channel",
+					Value: plan.SlackChannel.ValueString(),
+				},
+			},
+		}
+	}
+
+	if !plan.ProjectId.IsNull() {
+		id := plan.ProjectId.ValueString()
+		rule.Watched.Project = &models.ProjectJson{Id: &id}
+	}
+
+	if !plan.BuildConfigurationIds.IsNull() {
+		var ids []types.String
+		diags.Append(plan.BuildConfigurationIds.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return models.NotificationRuleJson{}, false
+		}
+		rule.Watched.BuildTypes = &models.BuildTypesJson{}
+		for _, i := range ids {
+			rule.Watched.BuildTypes.BuildType = append(
+				rule.Watched.BuildTypes.BuildType,
+				models.BuildTypeJson{ID: i.ValueString()},
+			)
+		}
+	}
+
+	rule.Watched.BranchFilter = plan.BranchFilter.ValueString()
+
+	var events []types.String
+	diags.Append(plan.Events.ElementsAs(ctx, &events, false)...)
+	if diags.HasError() {
+		return models.NotificationRuleJson{}, false
+	}
+	for _, e := range events {
+		switch e.ValueString() {
+		case "build_started":
+			rule.Events.BuildStarted = true
+		case "build_failed_to_start":
+			rule.Events.BuildFailedToStart = true
+		case "build_failed":
+			rule.Events.BuildFailed = true
+		case "first_failure":
+			rule.Events.FirstFailureAfterSuccess = true
+		case "build_successful":
+			rule.Events.BuildFinishedSuccessfully = true
+		case "build_fixed":
+			rule.Events.FirstSuccessAfterFailure = true
+		case "build_hanging":
+			rule.Events.BuildProbablyHanging = true
+		case "investigation_assigned":
+			rule.Events.ResponsibilityAssigned = true
+		case "investigation_updated":
+			rule.Events.ResponsibilityChanged = true
+		}
+	}
+
+	return rule, true
+}
+
+func (r *notificationRuleResource) readState(actual models.NotificationRuleJson, plan notificationRuleResourceModel) notificationRuleResourceModel {
+	var newState notificationRuleResourceModel
+	newState.Id = plan.Id
+	if actual.Id != nil {
+		newState.Id = types.StringValue(strconv.FormatInt(*actual.Id, 10))
+	}
+	newState.UserId = plan.UserId
+	newState.GroupId = plan.GroupId
+
+	if actual.Notifier == slackNotifier {
+		newState.Notifier = types.StringValue("slack")
+	} else {
+		newState.Notifier = types.StringValue("email")
+	}
+
+	if actual.Properties != nil {
+		for _, p := range actual.Properties.Property {
+			switch p.Name {
+			case "connection":
+				newState.SlackConnectionId = types.StringValue(p.Value)
+			case "channel":
+				newState.SlackChannel = types.StringValue(p.Value)
+			}
+		}
+	}
+
+	if actual.Watched.Project != nil && actual.Watched.Project.Id != nil {
+		newState.ProjectId = types.StringValue(*actual.Watched.Project.Id)
+	}
+
+	newState.BuildConfigurationIds = types.SetNull(types.StringType)
+	if actual.Watched.BuildTypes != nil && len(actual.Watched.BuildTypes.BuildType) > 0 {
+		var ids []attr.Value
+		for _, i := range actual.Watched.BuildTypes.BuildType {
+			ids = append(ids, types.StringValue(i.ID))
+		}
+		newState.BuildConfigurationIds = types.SetValueMust(types.StringType, ids)
+	}
+
+	if actual.Watched.BranchFilter != "" {
+		newState.BranchFilter = types.StringValue(actual.Watched.BranchFilter)
+	}
+
+	flags := []bool{
+		actual.Events.BuildStarted,
+		actual.Events.BuildFailedToStart,
+		actual.Events.BuildFailed,
+		actual.Events.FirstFailureAfterSuccess,
+		actual.Events.BuildFinishedSuccessfully,
+		actual.Events.FirstSuccessAfterFailure,
+		actual.Events.BuildProbablyHanging,
+		actual.Events.ResponsibilityAssigned,
+		actual.Events.ResponsibilityChanged,
+	}
+	events := []attr.Value{}
+	for i, enabled := range flags {
+		if enabled {
+			events = append(events, types.StringValue(notificationEvents[i]))
+		}
+	}
+	newState.Events = types.SetValueMust(types.StringType, events)
+
+	return newState
+}
@@ -0,0 +1,69 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccNotificationRule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                    resource "teamcity_group" "test" {
+                        name = "test_notification_group"
+                    }
+
+                    resource "teamcity_notification_rule" "test" {
+                        group_id      = teamcity_group.test.id
+                        notifier      = "email"
+                        project_id    = "_Root"
+                        branch_filter = "+:<default>"
+                        events        = ["build_failed", "first_failure", "build_fixed"]
+                    }
+                `,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("teamcity_notification_rule.test", "id"),
+					resource.TestCheckResourceAttr("teamcity_notification_rule.test", "notifier", "email"),
+					resource.TestCheckResourceAttr("teamcity_notification_rule.test", "project_id", "_Root"),
+					resource.TestCheckResourceAttr("teamcity_notification_rule.test", "branch_filter", "+:<default>"),
+					resource.TestCheckResourceAttr("teamcity_notification_rule.test", "events.#", "3"),
+					resource.TestCheckTypeSetElemAttr("teamcity_notification_rule.test", "events.*", "first_failure"),
+				),
+			},
+			{
+				ResourceName:      "teamcity_notification_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["teamcity_notification_rule.test"]
+					if !ok {
+						return "", nil
+					}
+					return "group:" + rs.Primary.Attributes["group_id"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+			{
+				Config: providerConfig + `
+                    resource "teamcity_group" "test" {
+                        name = "test_notification_group"
+                    }
+
+                    resource "teamcity_notification_rule" "test" {
+                        group_id   = teamcity_group.test.id
+                        notifier   = "email"
+                        project_id = "_Root"
+                        events     = ["build_started", "investigation_assigned"]
+                    }
+                `,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("teamcity_notification_rule.test", "branch_filter"),
+					resource.TestCheckResourceAttr("teamcity_notification_rule.test", "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr("teamcity_notification_rule.test", "events.*", "investigation_assigned"),
+				),
+			},
+		},
+	})
+}
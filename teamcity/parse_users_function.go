@@ -0,0 +1,277 @@
+package teamcity
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseUsersFunction{}
+
+func NewParseUsersFunction() function.Function {
+	return &parseUsersFunction{}
+}
+
+type parseUsersFunction struct{}
+
+// rosterUser is a normalized entry of a user roster, Line is where it starts in the input.
+type rosterUser struct {
+	Username string
+	Name     string
+	Email    string
+	Groups   []string
+	Line     int
+}
+
+type rosterUserModel struct {
+	Username types.String   `tfsdk:"username"`
+	Name     types.String   `tfsdk:"name"`
+	Email    types.String   `tfsdk:"email"`
+	Groups   []types.String `tfsdk:"groups"`
+}
+
+var rosterUserType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"username": types.StringType,
+		"name":     types.StringType,
+		"email":    types.StringType,
+		"groups":   types.ListType{ElemType: types.StringType},
+	},
+}
+
+func (f *parseUsersFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_users"
+}
+
+func (f *parseUsersFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a user roster",
+		MarkdownDescription: "Validates and normalizes a JSON or CSV user roster into a map of users keyed by username, ready for `for_each` on `teamcity_user`. " +
+			"JSON is an array of objects, CSV has a header row. Both use the fields `username` (required), `name`, `email` and `groups` " +
+			"(an array in JSON, separated by `;` in CSV). Errors report the line of the offending row.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "content",
+				Description: "Content of the roster file, e.g. file(\"users.csv\").",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: rosterUserType,
+		},
+	}
+}
+
+func (f *parseUsersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	users, err := parseRoster(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := map[string]rosterUserModel{}
+	for _, u := range users {
+		user := rosterUserModel{
+			Username: types.StringValue(u.Username),
+			Name:     types.StringNull(),
+			Email:    types.StringNull(),
+			Groups:   []types.String{},
+		}
+		if u.Name != "" {
+			user.Name = types.StringValue(u.Name)
+		}
+		if u.Email != "" {
+			user.Email = types.StringValue(u.Email)
+		}
+		for _, g := range u.Groups {
+			user.Groups = append(user.Groups, types.StringValue(g))
+		}
+		result[u.Username] = user
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// parseRoster reads a JSON array or a CSV file, normalizes and validates the
+// users. All row errors are reported at once, one per line.
+func parseRoster(content string) ([]rosterUser, error) {
+	var users []rosterUser
+	var errs []rowError
+	var err error
+	if trimmed := strings.TrimSpace(content); strings.HasPrefix(trimmed, "[") {
+		users, errs, err = parseRosterJson(content)
+	} else {
+		users, errs, err = parseRosterCsv(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]int{}
+	for i := range users {
+		u := &users[i]
+		u.Username = strings.TrimSpace(u.Username)
+		u.Name = strings.TrimSpace(u.Name)
+		u.Email = strings.ToLower(strings.TrimSpace(u.Email))
+
+		var groups []string
+		for _, g := range u.Groups {
+			if g = strings.TrimSpace(g); g != "" && !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+		u.Groups = groups
+
+		if u.Username == "" {
+			errs = append(errs, rowError{u.Line, "username is required"})
+			continue
+		}
+		key := strings.ToLower(u.Username)
+		if first, ok := seen[key]; ok {
+			errs = append(errs, rowError{u.Line, fmt.Sprintf("duplicate username %q, first defined on line %d", u.Username, first)})
+			continue
+		}
+		seen[key] = u.Line
+		if u.Email != "" {
+			if address, err := mail.ParseAddress(u.Email); err != nil || address.Address != u.Email {
+				errs = append(errs, rowError{u.Line, fmt.Sprintf("invalid email %q", u.Email)})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		slices.SortStableFunc(errs, func(a, b rowError) int { return a.line - b.line })
+		messages := make([]string, len(errs))
+		for i, e := range errs {
+			messages[i] = fmt.Sprintf("line %d: %s", e.line, e.message)
+		}
+		return nil, errors.New(strings.Join(messages, "\n"))
+	}
+	return users, nil
+}
+
+// rowError is an error in a single roster row, reported along with the others.
+type rowError struct {
+	line    int
+	message string
+}
+
+// parseRosterJson returns the entries and the row errors, err is set when the input can't be read.
+func parseRosterJson(content string) (users []rosterUser, errs []rowError, err error) {
+	lineAt := func(offset int64) int {
+		// skip the separators preceding the value
+		for offset < int64(len(content)) && strings.ContainsRune(" \t\r\n,", rune(content[offset])) {
+			offset++
+		}
+		return strings.Count(content[:offset], "\n") + 1
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(content))
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, fmt.Errorf("line %d: %w", lineAt(decoder.InputOffset()), err)
+	}
+
+	for decoder.More() {
+		line := lineAt(decoder.InputOffset())
+		var entry struct {
+			Username string   `json:"username"`
+			Name     string   `json:"name"`
+			Email    string   `json:"email"`
+			Groups   []string `json:"groups"`
+		}
+		if err := decoder.Decode(&entry); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				// the input can't be read further
+				return nil, nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			errs = append(errs, rowError{line, fmt.Sprintf("field %s must be a %s", typeErr.Field, typeErr.Type)})
+			continue
+		}
+		users = append(users, rosterUser{
+			Username: entry.Username,
+			Name:     entry.Name,
+			Email:    entry.Email,
+			Groups:   entry.Groups,
+			Line:     line,
+		})
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, fmt.Errorf("line %d: %w", lineAt(decoder.InputOffset()), err)
+	}
+
+	return users, errs, nil
+}
+
+// parseRosterCsv returns the entries and the row errors, err is set when the input can't be read.
+func parseRosterCsv(content string) (users []rosterUser, errs []rowError, err error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["username"]; !ok {
+		return nil, nil, errors.New("line 1: the header must contain a username column")
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// csv.ParseError carries the line number
+			return nil, nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(header) {
+			errs = append(errs, rowError{line, fmt.Sprintf("expected %d fields, got %d", len(header), len(record))})
+			continue
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return record[i]
+			}
+			return ""
+		}
+		user := rosterUser{
+			Username: field("username"),
+			Name:     field("name"),
+			Email:    field("email"),
+			Line:     line,
+		}
+		if groups := field("groups"); groups != "" {
+			user.Groups = strings.Split(groups, ";")
+		}
+		users = append(users, user)
+	}
+
+	return users, errs, nil
+}
@@ -0,0 +1,129 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccParseUsersFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+locals {
+  users = provider::teamcity::parse_users(<<EOT
+username,name,email,groups
+jdoe, John Doe ,John.Doe@Example.com,DEVELOPERS;QA
+asmith,,,
+EOT
+  )
+}
+
+output "jdoe_name" {
+  value = local.users["jdoe"].name
+}
+
+output "jdoe_email" {
+  value = local.users["jdoe"].email
+}
+
+output "count" {
+  value = length(local.users)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("jdoe_name", "John Doe"),
+					resource.TestCheckOutput("jdoe_email", "john.doe@example.com"),
+					resource.TestCheckOutput("count", "2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+output "users" {
+  value = provider::teamcity::parse_users(jsonencode([{ username = "" }]))
+}
+`,
+				ExpectError: regexp.MustCompile("line 1: username is required"),
+			},
+		},
+	})
+}
+
+func TestParseRoster(t *testing.T) {
+	t.Run("csv", func(t *testing.T) {
+		users, err := parseRoster("username,name,email,groups\njdoe, John Doe ,John.Doe@Example.com,DEVELOPERS; QA;DEVELOPERS\n\nasmith,,,\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(users) != 2 {
+			t.Fatalf("expected 2 users, got %d", len(users))
+		}
+		jdoe := users[0]
+		if jdoe.Name != "John Doe" || jdoe.Email != "john.doe@example.com" || strings.Join(jdoe.Groups, ",") != "DEVELOPERS,QA" || jdoe.Line != 2 {
+			t.Fatalf("unexpected user: %+v", jdoe)
+		}
+		if users[1].Username != "asmith" || users[1].Line != 4 {
+			t.Fatalf("unexpected user: %+v", users[1])
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		users, err := parseRoster(`[
+  {"username": "jdoe", "email": "jdoe@example.com", "groups": ["QA"]},
+  {"username": "asmith", "extra": true}
+]`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(users) != 2 || users[0].Line != 2 || users[1].Line != 3 || users[0].Groups[0] != "QA" {
+			t.Fatalf("unexpected users: %+v", users)
+		}
+	})
+
+	errorTests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "csv-row-errors",
+			content:  "username,email\njdoe,not-an-email\n,x@example.com\nJDOE,\nbob\n",
+			expected: "line 2: invalid email \"not-an-email\"\nline 3: username is required\nline 4: duplicate username \"JDOE\", first defined on line 2\nline 5: expected 2 fields, got 1",
+		},
+		{
+			name:     "csv-field-count",
+			content:  "username,email\njdoe\n",
+			expected: "line 2: expected 2 fields, got 1",
+		},
+		{
+			name:     "csv-header",
+			content:  "login,email\njdoe,\n",
+			expected: "line 1: the header must contain a username column",
+		},
+		{
+			name:     "json-type",
+			content:  "[\n{\"username\": \"jdoe\"},\n{\"username\": 42}\n]",
+			expected: "line 3: field username must be a string",
+		},
+		{
+			name:     "json-syntax",
+			content:  "[\n{\"username\": \"jdoe\"},\n{\"username\" \"x\"}\n]",
+			expected: "line 3: invalid character",
+		},
+	}
+	for _, tc := range errorTests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseRoster(tc.content)
+			if err == nil || !strings.HasPrefix(err.Error(), tc.expected) {
+				t.Fatalf("got error %v, expected %q", err, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package teamcity
+
+import (
+	"context"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &permissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &permissionsDataSource{}
+)
+
+type permissionsDataSource struct {
+	client *client.Client
+}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+func (d *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+type permissionsDataSourceModel struct {
+	Ids         []types.String        `tfsdk:"ids"`
+	Permissions []permissionDataModel `tfsdk:"permissions"`
+}
+
+type permissionDataModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Global types.Bool   `tfsdk:"global"`
+}
+
+func (d *permissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the permissions known to the TeamCity server, e.g. to validate the permissions of a custom role.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted IDs of the permissions.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human readable description of the permission.",
+						},
+						"global": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the permission can only be granted globally.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *permissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *permissionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	permissions, err := d.client.GetPermissions()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading permissions",
+			err.Error(),
+		)
+		return
+	}
+
+	state := permissionsDataSourceModel{
+		Ids:         []types.String{},
+		Permissions: []permissionDataModel{},
+	}
+	for _, p := range permissions {
+		state.Ids = append(state.Ids, types.StringValue(p.Id))
+		state.Permissions = append(state.Permissions, permissionDataModel{
+			Id:     types.StringValue(p.Id),
+			Name:   types.StringValue(p.Name),
+			Global: types.BoolPointerValue(p.Global),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
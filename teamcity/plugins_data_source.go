@@ -0,0 +1,110 @@
+package teamcity
+
+import (
+	"context"
+	"sort"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &pluginsDataSource{}
+	_ datasource.DataSourceWithConfigure = &pluginsDataSource{}
+)
+
+type pluginsDataSource struct {
+	client *client.Client
+}
+
+func NewPluginsDataSource() datasource.DataSource {
+	return &pluginsDataSource{}
+}
+
+func (d *pluginsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugins"
+}
+
+type pluginsDataSourceModel struct {
+	Versions map[string]types.String `tfsdk:"versions"`
+	Plugins  []pluginDataModel       `tfsdk:"plugins"`
+}
+
+type pluginDataModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Version     types.String `tfsdk:"version"`
+	LoadPath    types.String `tfsdk:"load_path"`
+}
+
+func (d *pluginsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the plugins loaded by the TeamCity server, e.g. to check that a plugin required by a build runner is installed.",
+		Attributes: map[string]schema.Attribute{
+			"versions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Versions of the plugins keyed by plugin name.",
+			},
+			"plugins": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Plugins sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+						"load_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Location of the plugin on the server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pluginsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *pluginsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	plugins, err := d.client.GetPlugins()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading plugins",
+			err.Error(),
+		)
+		return
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+
+	state := pluginsDataSourceModel{
+		Versions: map[string]types.String{},
+		Plugins:  []pluginDataModel{},
+	}
+	for _, p := range plugins {
+		state.Versions[p.Name] = types.StringValue(p.Version)
+		state.Plugins = append(state.Plugins, pluginDataModel{
+			Name:        types.StringValue(p.Name),
+			DisplayName: types.StringValue(p.DisplayName),
+			Version:     types.StringValue(p.Version),
+			LoadPath:    types.StringValue(p.LoadPath),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,338 @@
+package teamcity
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &projectIamResource{}
+	_ resource.ResourceWithConfigure   = &projectIamResource{}
+	_ resource.ResourceWithImportState = &projectIamResource{}
+)
+
+func NewProjectIamResource() resource.Resource {
+	return &projectIamResource{}
+}
+
+type projectIamResource struct {
+	client *client.Client
+}
+
+type projectIamResourceModel struct {
+	Id        types.String                      `tfsdk:"id"`
+	ProjectId types.String                      `tfsdk:"project_id"`
+	Roles     map[string]projectIamBindingModel `tfsdk:"roles"`
+}
+
+type projectIamBindingModel struct {
+	Users  types.Set `tfsdk:"users"`
+	Groups types.Set `tfsdk:"groups"`
+}
+
+// projectIamBinding is the server side list of users and groups holding a role.
+type projectIamBinding struct {
+	users  []string
+	groups []string
+}
+
+func (r *projectIamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_iam"
+}
+
+func (r *projectIamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the roles granted to users and groups in a project. Role assignments in the project scope not listed here are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource identifier (same as project_id).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"roles": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Role bindings keyed by role ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"users": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Usernames of the users holding the role in the project.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"groups": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Keys of the groups holding the role in the project.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *projectIamResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *projectIamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectIamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.ProjectId
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectIamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectIamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.GetProject(state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project",
+			err.Error(),
+		)
+		return
+	}
+	if project == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	bindings, err := r.readBindings(state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project role assignments",
+			err.Error(),
+		)
+		return
+	}
+
+	roles := map[string]projectIamBindingModel{}
+	for roleId, binding := range bindings {
+		var model projectIamBindingModel
+		model.Users, diags = optionalSet(ctx, binding.users)
+		resp.Diagnostics.Append(diags...)
+		model.Groups, diags = optionalSet(ctx, binding.groups)
+		resp.Diagnostics.Append(diags...)
+		roles[roleId] = model
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = state.ProjectId
+	state.Roles = roles
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectIamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectIamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.ProjectId
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *projectIamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectIamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope := "p:" + state.ProjectId.ValueString()
+	for roleId, binding := range state.Roles {
+		for _, username := range setStrings(binding.Users) {
+			if err := r.client.RemoveUserRole(username, roleId, scope); err != nil {
+				resp.Diagnostics.AddError("Error removing role "+roleId+" from user "+username, err.Error())
+				return
+			}
+		}
+		for _, group := range setStrings(binding.Groups) {
+			if err := r.client.RemoveGroupRole(group, roleId, scope); err != nil {
+				resp.Diagnostics.AddError("Error removing role "+roleId+" from group "+group, err.Error())
+				return
+			}
+		}
+	}
+}
+
+func (r *projectIamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+// readBindings collects the roles assigned directly to users and groups in the project scope.
+func (r *projectIamResource) readBindings(projectId string) (map[string]*projectIamBinding, error) {
+	scope := "p:" + projectId
+	bindings := map[string]*projectIamBinding{}
+	binding := func(roleId string) *projectIamBinding {
+		if bindings[roleId] == nil {
+			bindings[roleId] = &projectIamBinding{}
+		}
+		return bindings[roleId]
+	}
+
+	users, err := r.client.GetUsers("")
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Roles == nil {
+			continue
+		}
+		for _, role := range user.Roles.RoleAssignment {
+			if role.Scope == scope {
+				b := binding(role.Id)
+				b.users = append(b.users, user.Username)
+			}
+		}
+	}
+
+	groups, err := r.client.GetGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.Roles == nil {
+			continue
+		}
+		for _, role := range group.Roles.RoleAssignment {
+			if role.Scope == scope {
+				b := binding(role.Id)
+				b.groups = append(b.groups, group.Key)
+			}
+		}
+	}
+
+	for _, b := range bindings {
+		sort.Strings(b.users)
+		sort.Strings(b.groups)
+	}
+	return bindings, nil
+}
+
+// reconcile grants the planned roles missing on the server and revokes every
+// other role assignment in the project scope.
+func (r *projectIamResource) reconcile(plan projectIamResourceModel, diags *diag.Diagnostics) {
+	projectId := plan.ProjectId.ValueString()
+	scope := "p:" + projectId
+
+	current, err := r.readBindings(projectId)
+	if err != nil {
+		diags.AddError("Error reading project role assignments", err.Error())
+		return
+	}
+
+	for roleId, planned := range plan.Roles {
+		actual := current[roleId]
+		if actual == nil {
+			actual = &projectIamBinding{}
+		}
+		for _, username := range setStrings(planned.Users) {
+			if slices.Contains(actual.users, username) {
+				continue
+			}
+			if err := r.client.AddUserRole(username, roleId, scope); err != nil {
+				diags.AddError("Error assigning role "+roleId+" to user "+username, err.Error())
+				return
+			}
+		}
+		for _, group := range setStrings(planned.Groups) {
+			if slices.Contains(actual.groups, group) {
+				continue
+			}
+			if err := r.client.AddGroupRole(group, roleId, scope); err != nil {
+				diags.AddError("Error assigning role "+roleId+" to group "+group, err.Error())
+				return
+			}
+		}
+	}
+
+	for roleId, actual := range current {
+		planned := plan.Roles[roleId]
+		for _, username := range actual.users {
+			if slices.Contains(setStrings(planned.Users), username) {
+				continue
+			}
+			if err := r.client.RemoveUserRole(username, roleId, scope); err != nil {
+				diags.AddError("Error removing role "+roleId+" from user "+username, err.Error())
+				return
+			}
+		}
+		for _, group := range actual.groups {
+			if slices.Contains(setStrings(planned.Groups), group) {
+				continue
+			}
+			if err := r.client.RemoveGroupRole(group, roleId, scope); err != nil {
+				diags.AddError("Error removing role "+roleId+" from group "+group, err.Error())
+				return
+			}
+		}
+	}
+}
+
+// optionalSet returns a null set for an empty list, matching an omitted attribute.
+func optionalSet(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,86 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccProjectIam_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "test" {
+  id   = "TestProjectIam"
+  name = "test_project_iam"
+}
+
+resource "teamcity_user" "test" {
+  username = "test_project_iam_user"
+}
+
+resource "teamcity_group" "test" {
+  name = "test_project_iam_group"
+}
+
+resource "teamcity_project_iam" "test" {
+  project_id = teamcity_project.test.id
+  roles = {
+    PROJECT_DEVELOPER = {
+      users  = [teamcity_user.test.username]
+      groups = [teamcity_group.test.id]
+    }
+    PROJECT_VIEWER = {
+      groups = [teamcity_group.test.id]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("teamcity_project_iam.test", "id", "teamcity_project.test", "id"),
+					resource.TestCheckResourceAttr("teamcity_project_iam.test", "roles.%", "2"),
+					resource.TestCheckResourceAttr("teamcity_project_iam.test", "roles.PROJECT_DEVELOPER.users.#", "1"),
+					resource.TestCheckResourceAttr("teamcity_project_iam.test", "roles.PROJECT_VIEWER.groups.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         "teamcity_project_iam.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+				ImportStateId:                        "TestProjectIam",
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "test" {
+  id   = "TestProjectIam"
+  name = "test_project_iam"
+}
+
+resource "teamcity_user" "test" {
+  username = "test_project_iam_user"
+}
+
+resource "teamcity_group" "test" {
+  name = "test_project_iam_group"
+}
+
+resource "teamcity_project_iam" "test" {
+  project_id = teamcity_project.test.id
+  roles = {
+    PROJECT_VIEWER = {
+      users = [teamcity_user.test.username]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_project_iam.test", "roles.%", "1"),
+					resource.TestCheckResourceAttr("teamcity_project_iam.test", "roles.PROJECT_VIEWER.users.#", "1"),
+					resource.TestCheckNoResourceAttr("teamcity_project_iam.test", "roles.PROJECT_VIEWER.groups"),
+				),
+			},
+		},
+	})
+}
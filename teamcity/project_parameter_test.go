@@ -2,6 +2,7 @@ package teamcity
 
 import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"testing"
 )
 
@@ -120,3 +121,58 @@ resource "teamcity_project_parameter" "secret" {
 		},
 	})
 }
+
+func TestAccProjectParameter_password_writeOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Write-only value is sent to the server but never stored in the state
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "p3" {
+  name = "Param Project 3"
+  id   = "param_project3"
+}
+
+resource "teamcity_project_parameter" "secret" {
+  project_id       = teamcity_project.p3.id
+  name             = "SECRET_TOKEN"
+  value_wo         = "s3cr3t"
+  value_wo_version = 1
+  type             = "password"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_project_parameter.secret", "type", "password"),
+					resource.TestCheckNoResourceAttr("teamcity_project_parameter.secret", "value"),
+					resource.TestCheckNoResourceAttr("teamcity_project_parameter.secret", "value_wo"),
+					resource.TestCheckResourceAttr("teamcity_project_parameter.secret", "value_wo_version", "1"),
+				),
+			},
+			// Bumping the version sends the new value
+			{
+				Config: providerConfig + `
+resource "teamcity_project" "p3" {
+  name = "Param Project 3"
+  id   = "param_project3"
+}
+
+resource "teamcity_project_parameter" "secret" {
+  project_id       = teamcity_project.p3.id
+  name             = "SECRET_TOKEN"
+  value_wo         = "n3w"
+  value_wo_version = 2
+  type             = "password"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("teamcity_project_parameter.secret", "value_wo"),
+					resource.TestCheckResourceAttr("teamcity_project_parameter.secret", "value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
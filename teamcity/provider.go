@@ -8,6 +8,7 @@ import (
 	"terraform-provider-teamcity/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +20,8 @@ import (
 const MaxRetriesDefault int = 12
 
 var (
-	_ provider.Provider = &teamcityProvider{}
+	_ provider.Provider              = &teamcityProvider{}
+	_ provider.ProviderWithFunctions = &teamcityProvider{}
 )
 
 func New() provider.Provider {
@@ -175,12 +177,24 @@ func (p *teamcityProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewSshKeyDataSource,
 		NewGroupDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
+		NewGroupsDataSource,
+		NewRoleDataSource,
+		NewPermissionsDataSource,
+		NewPluginsDataSource,
+		NewLicensesDataSource,
+		NewNodesDataSource,
 	}
 }
 
 func (p *teamcityProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCleanupResource,
+		NewCleanupRuleResource,
+		NewBackupResource,
+		NewNodeResponsibilitiesResource,
+		NewInternalPropertyResource,
+		NewProxySettingsResource,
 		NewPoolResource,
 		NewProjectResource,
 		NewBuildConfigurationResource,
@@ -196,6 +210,10 @@ func (p *teamcityProvider) Resources(_ context.Context) []func() resource.Resour
 		NewTokenResource,
 		NewGroupResource,
 		NewMemberResource,
+		NewMembersResource,
+		NewProjectIamResource,
+		NewUserTokenResource,
+		NewUserVcsUsernameResource,
 		NewLicenseResource,
 		NewParamResource,
 		NewBuildConfigurationParamResource,
@@ -203,6 +221,7 @@ func (p *teamcityProvider) Resources(_ context.Context) []func() resource.Resour
 		NewBuildConfigurationVcsRootResource,
 		NewBuildConfigurationStepResource,
 		NewBuildConfigurationFeatureResource,
+		NewBuildConfigurationFailureConditionResource,
 		NewBuildConfigurationTriggerResource,
 		NewAgentRequirementResource,
 		NewSnapshotDependencyResource,
@@ -211,5 +230,12 @@ func (p *teamcityProvider) Resources(_ context.Context) []func() resource.Resour
 		NewGroupRoleAssignmentResource,
 		NewUserRoleAssignmentResource,
 		NewCloudProfileResource,
+		NewNotificationRuleResource,
+	}
+}
+
+func (p *teamcityProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseUsersFunction,
 	}
 }
@@ -0,0 +1,284 @@
+package teamcity
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &proxySettingsResource{}
+	_ resource.ResourceWithConfigure   = &proxySettingsResource{}
+	_ resource.ResourceWithImportState = &proxySettingsResource{}
+)
+
+// proxySchemes are the prefixes of the internal properties configuring the
+// proxy, the same proxy is used for HTTP and HTTPS connections.
+var proxySchemes = []string{"teamcity.http.", "teamcity.https."}
+
+type proxySettingsResource struct {
+	client *client.Client
+}
+
+func NewProxySettingsResource() resource.Resource {
+	return &proxySettingsResource{}
+}
+
+type proxySettingsResourceModel struct {
+	Host          types.String `tfsdk:"host"`
+	Port          types.Int64  `tfsdk:"port"`
+	NonProxyHosts types.Set    `tfsdk:"non_proxy_hosts"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+}
+
+func (r *proxySettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_proxy_settings"
+}
+
+func (r *proxySettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "[Proxy](https://www.jetbrains.com/help/teamcity/how-to.html#Configure+TeamCity+to+Use+Proxy+Server+for+Outgoing+Connections) used by the server for outgoing HTTP and HTTPS connections, e.g. to GitHub or the plugin repository. " +
+			"The settings are stored as `teamcity.http.*` and `teamcity.https.*` internal properties, see `teamcity_internal_property`. Destroying the resource removes the proxy.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"non_proxy_hosts": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Hosts connected to directly, `*` matches any part of the host name, e.g. `*.example.com`.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "User name for basic authentication on the proxy.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+		},
+	}
+}
+
+func (r *proxySettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *proxySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan proxySettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkInternalPropertiesAvailable(r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.set(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *proxySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var oldState proxySettingsResourceModel
+	diags := req.State.Get(ctx, &oldState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, ok := r.read(ctx, oldState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *proxySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan proxySettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.set(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *proxySettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	for _, p := range proxyProperties(ctx, proxySettingsResourceModel{}, &resp.Diagnostics) {
+		if err := r.client.DeleteInternalProperty(p.name); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting internal property "+p.name,
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *proxySettingsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.State.Set(ctx, proxySettingsResourceModel{
+		NonProxyHosts: types.SetNull(types.StringType),
+	})
+}
+
+// set writes the internal properties of the proxy, properties without a value
+// are removed.
+func (r *proxySettingsResource) set(ctx context.Context, plan proxySettingsResourceModel, diags *diag.Diagnostics) proxySettingsResourceModel {
+	properties := proxyProperties(ctx, plan, diags)
+	if diags.HasError() {
+		return plan
+	}
+
+	for _, p := range properties {
+		var err error
+		if p.value == "" {
+			err = r.client.DeleteInternalProperty(p.name)
+		} else {
+			err = r.client.SetInternalProperty(p.name, p.value)
+		}
+		if err != nil {
+			diags.AddError(
+				"Error setting internal property "+p.name,
+				err.Error(),
+			)
+			return plan
+		}
+	}
+
+	newState, ok := r.read(ctx, plan, diags)
+	if !ok && !diags.HasError() {
+		diags.AddError(
+			"Error reading proxy settings",
+			"The proxy host is not set after setting it",
+		)
+	}
+	return newState
+}
+
+// read maps the HTTP proxy properties to the model, the HTTPS ones are set to
+// the same values. The password is kept from the state, false is returned
+// when no proxy is configured.
+func (r *proxySettingsResource) read(ctx context.Context, state proxySettingsResourceModel, diags *diag.Diagnostics) (proxySettingsResourceModel, bool) {
+	values := map[string]*string{}
+	for _, name := range []string{"proxyHost", "proxyPort", "nonProxyHosts", "proxyLogin"} {
+		value, err := r.client.GetInternalProperty(proxySchemes[0] + name)
+		if err != nil {
+			diags.AddError(
+				"Error reading internal property "+proxySchemes[0]+name,
+				err.Error(),
+			)
+			return state, false
+		}
+		values[name] = value
+	}
+	if values["proxyHost"] == nil {
+		return state, false
+	}
+
+	newState := proxySettingsResourceModel{
+		Host:          types.StringValue(*values["proxyHost"]),
+		Port:          types.Int64Null(),
+		NonProxyHosts: types.SetNull(types.StringType),
+		Username:      types.StringPointerValue(values["proxyLogin"]),
+		Password:      state.Password,
+	}
+	if values["proxyPort"] != nil {
+		port, err := strconv.ParseInt(*values["proxyPort"], 10, 64)
+		if err != nil {
+			diags.AddError(
+				"Error reading proxy port",
+				err.Error(),
+			)
+			return state, false
+		}
+		newState.Port = types.Int64Value(port)
+	}
+	if values["nonProxyHosts"] != nil && *values["nonProxyHosts"] != "" {
+		hosts, d := types.SetValueFrom(ctx, types.StringType, strings.Split(*values["nonProxyHosts"], "|"))
+		diags.Append(d...)
+		newState.NonProxyHosts = hosts
+	}
+	if newState.Username.IsNull() {
+		newState.Password = types.StringNull()
+	}
+	return newState, true
+}
+
+type proxyProperty struct {
+	name  string
+	value string
+}
+
+// proxyProperties returns the internal properties of the model for HTTP and
+// HTTPS connections, the value is empty for properties to remove.
+func proxyProperties(ctx context.Context, model proxySettingsResourceModel, diags *diag.Diagnostics) []proxyProperty {
+	var hosts []string
+	if !model.NonProxyHosts.IsNull() {
+		diags.Append(model.NonProxyHosts.ElementsAs(ctx, &hosts, false)...)
+		sort.Strings(hosts)
+	}
+	port := ""
+	if !model.Port.IsNull() {
+		port = strconv.FormatInt(model.Port.ValueInt64(), 10)
+	}
+
+	var properties []proxyProperty
+	for _, scheme := range proxySchemes {
+		properties = append(properties,
+			proxyProperty{scheme + "proxyHost", model.Host.ValueString()},
+			proxyProperty{scheme + "proxyPort", port},
+			proxyProperty{scheme + "nonProxyHosts", strings.Join(hosts, "|")},
+			proxyProperty{scheme + "proxyLogin", model.Username.ValueString()},
+			proxyProperty{scheme + "proxyPassword", model.Password.ValueString()},
+		)
+	}
+	return properties
+}
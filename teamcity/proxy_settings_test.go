@@ -0,0 +1,89 @@
+package teamcity
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProxySettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_proxy_settings" "test" {
+	host            = "proxy.example.com"
+	port            = 3128
+	non_proxy_hosts = ["localhost", "*.example.com"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_proxy_settings.test", "host", "proxy.example.com"),
+					resource.TestCheckResourceAttr("teamcity_proxy_settings.test", "port", "3128"),
+					resource.TestCheckResourceAttr("teamcity_proxy_settings.test", "non_proxy_hosts.#", "2"),
+					resource.TestCheckNoResourceAttr("teamcity_proxy_settings.test", "username"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_proxy_settings" "test" {
+	host     = "proxy.example.com"
+	port     = 8080
+	username = "teamcity"
+	password = "secret"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_proxy_settings.test", "port", "8080"),
+					resource.TestCheckResourceAttr("teamcity_proxy_settings.test", "username", "teamcity"),
+					resource.TestCheckNoResourceAttr("teamcity_proxy_settings.test", "non_proxy_hosts"),
+				),
+			},
+			{
+				ResourceName:                         "teamcity_proxy_settings.test",
+				ImportState:                          true,
+				ImportStateId:                        "proxy",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "host",
+				ImportStateVerifyIgnore:              []string{"password"},
+			},
+		},
+	})
+}
+
+func TestProxyProperties(t *testing.T) {
+	hosts, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"localhost", "*.example.com"})
+	model := proxySettingsResourceModel{
+		Host:          types.StringValue("proxy.example.com"),
+		Port:          types.Int64Value(3128),
+		NonProxyHosts: hosts,
+		Username:      types.StringNull(),
+		Password:      types.StringNull(),
+	}
+
+	var diags diag.Diagnostics
+	got := proxyProperties(context.Background(), model, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	want := []proxyProperty{
+		{"teamcity.http.proxyHost", "proxy.example.com"},
+		{"teamcity.http.proxyPort", "3128"},
+		{"teamcity.http.nonProxyHosts", "*.example.com|localhost"},
+		{"teamcity.http.proxyLogin", ""},
+		{"teamcity.http.proxyPassword", ""},
+		{"teamcity.https.proxyHost", "proxy.example.com"},
+		{"teamcity.https.proxyPort", "3128"},
+		{"teamcity.https.nonProxyHosts", "*.example.com|localhost"},
+		{"teamcity.https.proxyLogin", ""},
+		{"teamcity.https.proxyPassword", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("proxyProperties() = %v, want %v", got, want)
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strings"
 	"terraform-provider-teamcity/client"
 )
 
@@ -18,6 +21,7 @@ var (
 	_ resource.Resource                = &roleResource{}
 	_ resource.ResourceWithConfigure   = &roleResource{}
 	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.ResourceWithModifyPlan  = &roleResource{}
 )
 
 type roleResource struct {
@@ -62,88 +66,12 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"permissions": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
+				Description: "Permissions, e.g. `view_project` or `run_build`. The permissions known to the server are listed by the `teamcity_permissions` data source and checked when planning.",
 				Validators: []validator.Set{
-					setvalidator.ValueStringsAre(stringvalidator.OneOf([]string{
-						"administer_agent",
-						"administer_agent_for_project",
-						"archive_project",
-						"assign_investigation",
-						"assign_users_add_subgroups",
-						"authorize_agent",
-						"authorize_agent_for_project",
-						"backup",
-						"cancel_any_personal_build",
-						"cancel_build",
-						"change_agent_run_configuration_policy",
-						"change_agent_run_configuration_policy_for_project",
-						"change_cleanup_rules",
-						"change_https_settings",
-						//"change_own_profile",
-						"change_server_settings",
-						"change_user",
-						"change_user_notifications",
-						"change_user_notifications_in_project",
-						"change_user_roles_in_project",
-						"change_usergroup",
-						"change_vcs_username_in_project",
-						"clean_agent_sources",
-						"clean_build_configuration_sources",
-						"comment_build",
-						"configure_server_data_cleanup",
-						"connect_to_agent",
-						"create_delete_vcs_root",
-						"create_sub_project",
-						"create_user",
-						"create_usergroup",
-						"customize_build_parameters",
-						"customize_build_revisions",
-						"delete_sub_project",
-						"delete_user",
-						"delete_usergroup",
-						"edit_enforced_settings",
-						"edit_project",
-						"edit_vcs_modification",
-						"edit_versioned_settings",
-						"enable_disable_agent",
-						"enable_disable_agent_for_project",
-						"import_projects",
-						"label_build",
-						"manage_agent_clouds",
-						"manage_agent_pools",
-						"manage_agent_pools_for_project",
-						//"manage_authentication_settings",
-						"manage_build_problem_instances",
-						"manage_build_problems",
-						//"manage_custom_ssl_certificates",
-						"manage_experimental_features",
-						"manage_roles",
-						//"manage_server_installation",
-						"manage_server_licenses",
-						"patch_build_sources",
-						"pause_activate_build_configuration",
-						"pin_unpin_build",
-						"remove_agent",
-						"remove_agent_for_project",
-						"remove_build",
-						"reorder_build_queue",
-						"run_build",
-						"start_stop_cloud_agent",
-						"tag_build",
-						"view_agent_clouds",
-						"view_agent_details",
-						"view_agent_details_for_project",
-						"view_agent_usage_statistics",
-						"view_all_users",
-						"view_audit_log",
-						"view_build_configuration_settings",
-						"view_build_runtime_data",
-						"view_file_content",
-						"view_project",
-						"view_server_errors",
-						//"view_server_settings",
-						"view_usage_statistics",
-						"view_user_profile",
-					}...)),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z][a-z0-9_]*$`),
+						"must be a permission ID in lower case, e.g. view_project",
+					)),
 				},
 			},
 		},
@@ -157,6 +85,65 @@ func (r *roleResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = req.ProviderData.(*client.Client)
 }
 
+// ModifyPlan checks the permissions against the permissions known to the
+// server, they differ between TeamCity versions and installed plugins.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var permissions types.Set
+	diags := req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || permissions.IsNull() || permissions.IsUnknown() {
+		return
+	}
+
+	var planned []types.String
+	resp.Diagnostics.Append(permissions.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known, err := r.client.GetPermissions()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to check permissions",
+			"Could not read the permissions known to the server: "+err.Error(),
+		)
+		return
+	}
+
+	unknown := unknownPermissions(planned, known)
+	if len(unknown) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions"),
+			"Unknown permission",
+			"The server has no permission "+strings.Join(unknown, ", ")+". The permissions known to the server are listed by the `teamcity_permissions` data source.",
+		)
+	}
+}
+
+// unknownPermissions returns the known planned permissions missing on the server.
+func unknownPermissions(planned []types.String, known []client.Permission) []string {
+	ids := map[string]bool{}
+	for _, p := range known {
+		ids[p.Id] = true
+	}
+
+	var unknown []string
+	for _, p := range planned {
+		if p.IsUnknown() || p.IsNull() {
+			continue
+		}
+		if !ids[p.ValueString()] {
+			unknown = append(unknown, p.ValueString())
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan roleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -0,0 +1,165 @@
+package teamcity
+
+import (
+	"context"
+	"sort"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &roleDataSource{}
+	_ datasource.DataSourceWithConfigure = &roleDataSource{}
+)
+
+type roleDataSource struct {
+	client *client.Client
+}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &roleDataSource{}
+}
+
+func (d *roleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+type roleDataSourceModel struct {
+	Id                   types.String   `tfsdk:"id"`
+	Name                 types.String   `tfsdk:"name"`
+	Included             []types.String `tfsdk:"included"`
+	Permissions          []types.String `tfsdk:"permissions"`
+	EffectivePermissions []types.String `tfsdk:"effective_permissions"`
+}
+
+func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get information about a built-in or custom role, e.g. `PROJECT_DEVELOPER`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the role.",
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"included": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the roles included in the role.",
+			},
+			"permissions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Permissions granted by the role itself.",
+			},
+			"effective_permissions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Permissions granted by the role and all the roles it includes.",
+			},
+		},
+	}
+}
+
+func (d *roleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config roleDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.GetRoles()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading roles",
+			err.Error(),
+		)
+		return
+	}
+
+	byId := map[string]client.Role{}
+	for _, role := range roles {
+		if role.Id != nil {
+			byId[*role.Id] = role
+		}
+	}
+
+	role, ok := byId[config.Id.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Role not found",
+			"The role '"+config.Id.ValueString()+"' was not found",
+		)
+		return
+	}
+
+	state := roleDataSourceModel{
+		Id:                   config.Id,
+		Name:                 types.StringValue(stringValue(role.Name)),
+		Included:             []types.String{},
+		Permissions:          []types.String{},
+		EffectivePermissions: []types.String{},
+	}
+	if role.Included != nil {
+		for _, included := range role.Included.Role {
+			state.Included = append(state.Included, types.StringValue(stringValue(included.Id)))
+		}
+	}
+	if role.Permissions != nil {
+		for _, p := range role.Permissions.Permission {
+			state.Permissions = append(state.Permissions, types.StringValue(p.Id))
+		}
+	}
+	for _, p := range effectivePermissions(byId, config.Id.ValueString()) {
+		state.EffectivePermissions = append(state.EffectivePermissions, types.StringValue(p))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// effectivePermissions returns the sorted permissions of the role and of the roles it includes, recursively.
+func effectivePermissions(roles map[string]client.Role, id string) []string {
+	visited := map[string]bool{}
+	permissions := map[string]bool{}
+
+	var visit func(string)
+	visit = func(id string) {
+		if visited[id] {
+			return
+		}
+		visited[id] = true
+
+		role := roles[id]
+		if role.Permissions != nil {
+			for _, p := range role.Permissions.Permission {
+				permissions[p.Id] = true
+			}
+		}
+		if role.Included != nil {
+			for _, included := range role.Included.Role {
+				visit(stringValue(included.Id))
+			}
+		}
+	}
+	visit(id)
+
+	result := make([]string, 0, len(permissions))
+	for p := range permissions {
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,60 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"slices"
+	"terraform-provider-teamcity/client"
+	"testing"
+)
+
+func TestAccRoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "teamcity_role" "developer" {
+  id = "PROJECT_DEVELOPER"
+}
+
+data "teamcity_permissions" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.teamcity_role.developer", "name", "Project developer"),
+					resource.TestCheckResourceAttr("data.teamcity_role.developer", "included.0", "PROJECT_VIEWER"),
+					resource.TestCheckTypeSetElemAttr("data.teamcity_role.developer", "permissions.*", "run_build"),
+					resource.TestCheckTypeSetElemAttr("data.teamcity_role.developer", "effective_permissions.*", "view_project"),
+					resource.TestCheckTypeSetElemAttr("data.teamcity_permissions.all", "ids.*", "change_server_settings"),
+				),
+			},
+		},
+	})
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	id := func(s string) *string { return &s }
+	roles := map[string]client.Role{
+		"VIEWER": {
+			Id:          id("VIEWER"),
+			Permissions: &client.Permissions{Permission: []client.Permission{{Id: "view_project"}}},
+		},
+		"DEVELOPER": {
+			Id:          id("DEVELOPER"),
+			Included:    &client.Included{Role: []client.Role{{Id: id("VIEWER")}, {Id: id("ADMIN")}}},
+			Permissions: &client.Permissions{Permission: []client.Permission{{Id: "run_build"}, {Id: "view_project"}}},
+		},
+		"ADMIN": {
+			Id:          id("ADMIN"),
+			Included:    &client.Included{Role: []client.Role{{Id: id("DEVELOPER")}}},
+			Permissions: &client.Permissions{Permission: []client.Permission{{Id: "edit_project"}}},
+		},
+	}
+
+	expected := []string{"edit_project", "run_build", "view_project"}
+	if actual := effectivePermissions(roles, "DEVELOPER"); !slices.Equal(actual, expected) {
+		t.Fatalf("got %v, expected %v", actual, expected)
+	}
+	if actual := effectivePermissions(roles, "VIEWER"); !slices.Equal(actual, []string{"view_project"}) {
+		t.Fatalf("got %v, expected [view_project]", actual)
+	}
+}
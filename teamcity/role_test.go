@@ -1,7 +1,11 @@
 package teamcity
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"reflect"
+	"regexp"
+	"terraform-provider-teamcity/client"
 	"testing"
 )
 
@@ -62,3 +66,37 @@ resource "teamcity_role" "test" {
 		},
 	})
 }
+
+func TestAccRole_unknownPermission(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_role" "test" {
+	name = "Test Role"
+	permissions = ["view_project", "no_such_permission"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("no_such_permission"),
+			},
+		},
+	})
+}
+
+func TestUnknownPermissions(t *testing.T) {
+	known := []client.Permission{{Id: "run_build"}, {Id: "view_project"}}
+	planned := []types.String{
+		types.StringValue("view_project"),
+		types.StringValue("zz_plugin_permission"),
+		types.StringUnknown(),
+		types.StringValue("edit_project"),
+	}
+
+	got := unknownPermissions(planned, known)
+	want := []string{"edit_project", "zz_plugin_permission"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unknownPermissions() = %v, want %v", got, want)
+	}
+}
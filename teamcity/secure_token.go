@@ -2,8 +2,10 @@ package teamcity
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +29,8 @@ type tokenResourceModel struct {
 	Id      types.String `tfsdk:"id"`
 	Project types.String `tfsdk:"project_id"`
 	Value   types.String `tfsdk:"value"`
+	ValueWo types.String `tfsdk:"value_wo"`
+	Version types.Int64  `tfsdk:"value_wo_version"`
 }
 
 func (r *tokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,12 +51,14 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"value": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"value_wo":         writeOnlyAttribute("value", true),
+			"value_wo_version": writeOnlyVersionAttribute("value", int64planmodifier.RequiresReplace()),
 		},
 	}
 }
@@ -72,7 +78,12 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	id, err := r.client.AddSecureToken(plan.Project.ValueString(), plan.Value.ValueString())
+	valueWo := writeOnlyValue(ctx, req.Config, path.Root("value_wo"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.AddSecureToken(plan.Project.ValueString(), secretValue(plan.Value, valueWo).ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error adding secure token",
@@ -85,6 +96,7 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 	newState.Project = plan.Project
 	newState.Id = types.StringValue(*id)
 	newState.Value = plan.Value
+	newState.Version = plan.Version
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -116,6 +128,7 @@ func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			newState.Project = state.Project
 			newState.Id = state.Id
 			newState.Value = state.Value
+			newState.Version = state.Version
 
 			diags = resp.State.Set(ctx, newState)
 			resp.Diagnostics.Append(diags...)
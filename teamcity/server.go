@@ -2,6 +2,7 @@ package teamcity
 
 import (
 	"context"
+	"errors"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,7 +22,36 @@ type serverDataSource struct {
 	client *client.Client
 }
 type serverDataSourceModel struct {
-	Version types.String `tfsdk:"version"`
+	Version         types.String            `tfsdk:"version"`
+	VersionMajor    types.Int64             `tfsdk:"version_major"`
+	VersionMinor    types.Int64             `tfsdk:"version_minor"`
+	BuildNumber     types.String            `tfsdk:"build_number"`
+	StartTime       types.String            `tfsdk:"start_time"`
+	NodeId          types.String            `tfsdk:"node_id"`
+	NodeRole        types.String            `tfsdk:"node_role"`
+	MaintenanceMode types.Bool              `tfsdk:"maintenance_mode"`
+	License         *serverLicenseModel     `tfsdk:"license"`
+	HealthItems     []serverHealthItemModel `tfsdk:"health_items"`
+}
+
+type serverLicenseModel struct {
+	Type                         types.String `tfsdk:"type"`
+	Exceeded                     types.Bool   `tfsdk:"exceeded"`
+	UnlimitedAgents              types.Bool   `tfsdk:"unlimited_agents"`
+	MaxAgents                    types.Int64  `tfsdk:"max_agents"`
+	AgentsUsed                   types.Int64  `tfsdk:"agents_used"`
+	AgentsLeft                   types.Int64  `tfsdk:"agents_left"`
+	UnlimitedBuildConfigurations types.Bool   `tfsdk:"unlimited_build_configurations"`
+	MaxBuildConfigurations       types.Int64  `tfsdk:"max_build_configurations"`
+	BuildConfigurationsUsed      types.Int64  `tfsdk:"build_configurations_used"`
+	BuildConfigurationsLeft      types.Int64  `tfsdk:"build_configurations_left"`
+}
+
+type serverHealthItemModel struct {
+	Identity     types.String `tfsdk:"identity"`
+	Severity     types.String `tfsdk:"severity"`
+	Category     types.String `tfsdk:"category"`
+	CategoryName types.String `tfsdk:"category_name"`
 }
 
 func (d *serverDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
@@ -36,12 +66,93 @@ func (d *serverDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 }
 
 func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	limit := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Computed:    true,
+			Description: description,
+		}
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "Data Source for retrieving the TeamCity server version",
+		Description: "Data Source for retrieving the TeamCity server version, license usage and health. " +
+			"While the server is in maintenance mode only `maintenance_mode` is set. " +
+			"The node, license and health attributes are null with a warning when the token is not allowed to read them.",
 		Attributes: map[string]schema.Attribute{
 			"version": schema.StringAttribute{
 				Computed: true,
 			},
+			"version_major": schema.Int64Attribute{
+				Computed: true,
+			},
+			"version_minor": schema.Int64Attribute{
+				Computed: true,
+			},
+			"build_number": schema.StringAttribute{
+				Computed: true,
+			},
+			"start_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time the server was started, in RFC 3339 format.",
+			},
+			"node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the node serving the requests of the provider.",
+			},
+			"node_role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Role of the node serving the requests of the provider, e.g. `main_node` or `secondary_node`.",
+			},
+			"maintenance_mode": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the server is in maintenance mode, i.e. starting, upgrading or waiting for an administrator.",
+			},
+			"license": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "License usage of the server.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Type of the server license, e.g. `professional` or `enterprise`.",
+					},
+					"exceeded": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether more agents or build configurations are used than licensed.",
+					},
+					"unlimited_agents": schema.BoolAttribute{
+						Computed: true,
+					},
+					"max_agents":  limit("Number of licensed agents."),
+					"agents_used": limit("Number of agents using a license, null with unlimited agents."),
+					"agents_left": limit("Number of agents that can still be authorized."),
+					"unlimited_build_configurations": schema.BoolAttribute{
+						Computed: true,
+					},
+					"max_build_configurations":  limit("Number of licensed build configurations."),
+					"build_configurations_used": limit("Number of build configurations using a license, null with unlimited build configurations."),
+					"build_configurations_left": limit("Number of build configurations that can still be created."),
+				},
+			},
+			"health_items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Active [server health](https://www.jetbrains.com/help/teamcity/server-health.html) items.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity": schema.StringAttribute{
+							Computed: true,
+						},
+						"severity": schema.StringAttribute{
+							Computed:    true,
+							Description: "Severity of the item: `INFO`, `WARN` or `ERROR`.",
+						},
+						"category": schema.StringAttribute{
+							Computed: true,
+						},
+						"category_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -49,7 +160,13 @@ func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 func (d *serverDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state serverDataSourceModel
 
-	version, err := d.client.GetVersion()
+	server, err := d.client.GetServer()
+	if errors.Is(err, client.ErrMaintenance) {
+		state.MaintenanceMode = types.BoolValue(true)
+		diags := resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read version",
@@ -58,7 +175,59 @@ func (d *serverDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 		return
 	}
 
-	state.Version = types.StringValue(version)
+	state.Version = types.StringValue(server.Version)
+	state.VersionMajor = types.Int64Value(server.VersionMajor)
+	state.VersionMinor = types.Int64Value(server.VersionMinor)
+	state.BuildNumber = types.StringValue(server.BuildNumber)
+	state.StartTime = types.StringValue(teamcityTime(server.StartTime))
+	state.MaintenanceMode = types.BoolValue(false)
+
+	node, err := d.client.GetCurrentNode()
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddWarning("Unable to read the current node", err.Error())
+	}
+	if node != nil {
+		state.NodeId = types.StringValue(node.Id)
+		state.NodeRole = types.StringValue(node.Role)
+	}
+
+	license, err := d.client.GetLicensingData()
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to read licensing data", err.Error())
+	} else {
+		state.License = &serverLicenseModel{
+			Type:                         types.StringValue(license.ServerLicenseType),
+			Exceeded:                     types.BoolValue(license.LicenseUseExceeded),
+			UnlimitedAgents:              types.BoolValue(license.UnlimitedAgents),
+			MaxAgents:                    types.Int64Value(license.MaxAgents),
+			AgentsUsed:                   licenseUsed(license.UnlimitedAgents, license.MaxAgents, license.AgentsLeft),
+			AgentsLeft:                   types.Int64Value(license.AgentsLeft),
+			UnlimitedBuildConfigurations: types.BoolValue(license.UnlimitedBuildTypes),
+			MaxBuildConfigurations:       types.Int64Value(license.MaxBuildTypes),
+			BuildConfigurationsUsed:      licenseUsed(license.UnlimitedBuildTypes, license.MaxBuildTypes, license.BuildTypesLeft),
+			BuildConfigurationsLeft:      types.Int64Value(license.BuildTypesLeft),
+		}
+	}
+
+	items, err := d.client.GetHealthItems()
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to read health items", err.Error())
+	} else {
+		state.HealthItems = []serverHealthItemModel{}
+		for _, item := range items {
+			model := serverHealthItemModel{
+				Identity:     types.StringValue(item.Identity),
+				Severity:     types.StringValue(item.Severity),
+				Category:     types.StringNull(),
+				CategoryName: types.StringNull(),
+			}
+			if item.HealthCategory != nil {
+				model.Category = types.StringValue(item.HealthCategory.Id)
+				model.CategoryName = types.StringValue(item.HealthCategory.Name)
+			}
+			state.HealthItems = append(state.HealthItems, model)
+		}
+	}
 
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -66,3 +235,12 @@ func (d *serverDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 		return
 	}
 }
+
+// licenseUsed is the number of licenses in use, the server only reports the
+// licenses left.
+func licenseUsed(unlimited bool, licensed, left int64) types.Int64 {
+	if unlimited {
+		return types.Int64Null()
+	}
+	return types.Int64Value(licensed - left)
+}
@@ -19,6 +19,13 @@ func TestServerVersion(t *testing.T) {
 						"version",
 						regexp.MustCompile(`^20\d{2}\.\d{1,2}(\.\d{1,2})?( EAP)?( \(build \d+\))?$`),
 					),
+					resource.TestMatchResourceAttr("data.teamcity_server.test", "version_major", regexp.MustCompile(`^20\d{2}$`)),
+					resource.TestMatchResourceAttr("data.teamcity_server.test", "build_number", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr("data.teamcity_server.test", "maintenance_mode", "false"),
+					resource.TestCheckResourceAttrSet("data.teamcity_server.test", "start_time"),
+					resource.TestCheckResourceAttrSet("data.teamcity_server.test", "node_id"),
+					resource.TestCheckResourceAttrSet("data.teamcity_server.test", "license.max_agents"),
+					resource.TestCheckResourceAttrSet("data.teamcity_server.test", "health_items.#"),
 				),
 			},
 		},
@@ -2,8 +2,10 @@ package teamcity
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-teamcity/client"
 )
@@ -25,6 +27,8 @@ type sshKeyResourceModel struct {
 	Project types.String `tfsdk:"project_id"`
 	Name    types.String `tfsdk:"name"`
 	Key     types.String `tfsdk:"private_key"`
+	KeyWo   types.String `tfsdk:"private_key_wo"`
+	Version types.Int64  `tfsdk:"private_key_wo_version"`
 }
 
 func (r *sshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,9 +46,11 @@ func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required: true,
 			},
 			"private_key": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
+			"private_key_wo":         writeOnlyAttribute("private_key", true),
+			"private_key_wo_version": writeOnlyVersionAttribute("private_key", int64planmodifier.RequiresReplace()),
 		},
 	}
 }
@@ -64,7 +70,12 @@ func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	err := r.client.NewSshKey(plan.Project.ValueString(), plan.Name.ValueString(), plan.Key.ValueString())
+	keyWo := writeOnlyValue(ctx, req.Config, path.Root("private_key_wo"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.NewSshKey(plan.Project.ValueString(), plan.Name.ValueString(), secretValue(plan.Key, keyWo).ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error adding SSH key",
@@ -77,6 +88,7 @@ func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	newState.Project = plan.Project
 	newState.Name = plan.Name
 	newState.Key = plan.Key
+	newState.Version = plan.Version
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -116,6 +128,7 @@ func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		Project: state.Project,
 		Name:    state.Name,
 		Key:     state.Key,
+		Version: state.Version,
 	}
 
 	diags = resp.State.Set(ctx, newState)
@@ -2,15 +2,22 @@ package teamcity
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
 	"strconv"
 	"terraform-provider-teamcity/client"
 	"terraform-provider-teamcity/models"
+	"time"
 )
 
 var (
@@ -33,13 +40,20 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 type userResourceModel struct {
-	Id       types.String     `tfsdk:"id"`
-	Username types.String     `tfsdk:"username"`
-	Password types.String     `tfsdk:"password"`
-	Github   types.String     `tfsdk:"github_username"`
-	Roles    []roleAssignment `tfsdk:"roles"`
+	Id         types.String     `tfsdk:"id"`
+	Username   types.String     `tfsdk:"username"`
+	Name       types.String     `tfsdk:"name"`
+	Email      types.String     `tfsdk:"email"`
+	Password   types.String     `tfsdk:"password"`
+	Github     types.String     `tfsdk:"github_username"`
+	Properties types.Map        `tfsdk:"properties"`
+	Groups     types.Set        `tfsdk:"groups"`
+	Roles      []roleAssignment `tfsdk:"roles"`
+	LastLogin  types.String     `tfsdk:"last_login"`
 }
 
+const githubUsernameProperty = "plugin:auth:GitHubApp-oauth:userName"
+
 type roleAssignment struct {
 	Id      types.String `tfsdk:"id"`
 	Global  types.Bool   `tfsdk:"global"`
@@ -63,9 +77,45 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:  true,
 				Sensitive: true,
 			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Full name of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"github_username": schema.StringAttribute{
 				Optional: true,
 			},
+			"properties": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User properties, e.g. VCS usernames (`plugin:vcs:jetbrains.git:anyVcsRoot`) or notifier settings. Only the properties listed here are managed, other properties of the user are kept.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOf(githubUsernameProperty)),
+				},
+			},
+			"groups": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Keys of the groups the user is a member of. When set, the membership is authoritative. Leave unset when memberships are managed with `teamcity_group_member` or `teamcity_group_members`.",
+			},
+			"last_login": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time of the last login in RFC 3339 format, empty if the user never logged in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"roles": schema.SetNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -109,10 +159,10 @@ func (r *userResource) ValidateConfig(ctx context.Context, req resource.Validate
 				"",
 			)
 		}
-		if !role.Global.ValueBool() {
+		if !role.Global.IsNull() && !role.Global.IsUnknown() && !role.Global.ValueBool() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("roles"), //TODO path to specific set item
-				"'global' must be set to 'true'",
+				"'global' must be set to 'true', use 'project' for project roles",
 				"",
 			)
 		}
@@ -134,7 +184,10 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	user := r.update(plan)
+	user := r.update(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	actual, err := r.client.NewUser(user)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -144,7 +197,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	newState := r.readState(actual)
+	newState := r.readState(ctx, actual, plan, &resp.Diagnostics)
 	newState.Password = plan.Password
 
 	diags = resp.State.Set(ctx, newState)
@@ -182,7 +235,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	newState := r.readState(actual)
+	newState := r.readState(ctx, actual, oldState, &resp.Diagnostics)
 	newState.Password = oldState.Password
 
 	diags = resp.State.Set(ctx, newState)
@@ -208,7 +261,10 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
-	user := r.update(plan)
+	user := r.update(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	user.Id = &id
 
 	result, err := r.client.SetUser(user)
@@ -220,7 +276,35 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	newState := r.readState(result)
+	// Properties removed from the configuration are deleted explicitly,
+	// the user update does not touch properties it doesn't list.
+	var state userResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned := plan.Properties.Elements()
+	removed := false
+	for name := range state.Properties.Elements() {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+		if _, err := r.client.SetField("users", plan.Id.ValueString(), "properties/"+name, nil); err != nil {
+			resp.Diagnostics.AddError("Error deleting user property "+name, err.Error())
+			return
+		}
+		removed = true
+	}
+	if removed {
+		result, err = r.client.GetUser(plan.Id.ValueString())
+		if err != nil || result == nil {
+			resp.Diagnostics.AddError("Error reading user", fmt.Sprintf("Could not read user %s after update: %v", plan.Id.ValueString(), err))
+			return
+		}
+	}
+
+	newState := r.readState(ctx, result, plan, &resp.Diagnostics)
 	newState.Password = plan.Password
 
 	diags = resp.State.Set(ctx, newState)
@@ -230,24 +314,50 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 }
 
-func (r *userResource) update(plan userResourceModel) client.User {
+func (r *userResource) update(ctx context.Context, plan userResourceModel, diags *diag.Diagnostics) client.User {
 	user := client.User{
 		Username: plan.Username.ValueString(),
 	}
 
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		name := plan.Name.ValueString()
+		user.Name = &name
+	}
+
+	if !plan.Email.IsNull() && !plan.Email.IsUnknown() {
+		email := plan.Email.ValueString()
+		user.Email = &email
+	}
+
 	if plan.Password.IsNull() != true {
 		password := plan.Password.ValueString()
 		user.Password = &password
 	}
 
+	var props []models.Property
 	if plan.Github.IsNull() != true {
-		user.Properties = &models.Properties{
-			Property: []models.Property{
-				{
-					Name:  "plugin:auth:GitHubApp-oauth:userName",
-					Value: plan.Github.ValueString(),
-				},
-			},
+		props = append(props, models.Property{
+			Name:  githubUsernameProperty,
+			Value: plan.Github.ValueString(),
+		})
+	}
+	if !plan.Properties.IsNull() {
+		values := make(map[string]string, len(plan.Properties.Elements()))
+		diags.Append(plan.Properties.ElementsAs(ctx, &values, false)...)
+		for _, name := range sortedKeys(values) {
+			props = append(props, models.Property{Name: name, Value: values[name]})
+		}
+	}
+	if props != nil {
+		user.Properties = &models.Properties{Property: props}
+	}
+
+	if !plan.Groups.IsNull() {
+		var keys []string
+		diags.Append(plan.Groups.ElementsAs(ctx, &keys, false)...)
+		user.Groups = &client.UserGroups{Group: []models.GroupJson{}}
+		for _, key := range keys {
+			user.Groups.Group = append(user.Groups.Group, models.GroupJson{Key: key})
 		}
 	}
 
@@ -293,16 +403,78 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
 }
 
-func (r *userResource) readState(actual *client.User) userResourceModel {
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+const teamcityTimeFormat = "20060102T150405-0700"
+
+// teamcityTime converts a TeamCity timestamp (20060102T150405-0700) to RFC 3339.
+// Values in an unexpected format are returned as is.
+func teamcityTime(value string) string {
+	t, err := time.Parse(teamcityTimeFormat, value)
+	if err != nil {
+		return value
+	}
+	return t.Format(time.RFC3339)
+}
+
+// readState maps the server user to the model. Properties and groups are only
+// read when they are managed, i.e. not null in the prior state or plan.
+func (r *userResource) readState(ctx context.Context, actual *client.User, prior userResourceModel, diags *diag.Diagnostics) userResourceModel {
 	var newState userResourceModel
 	newState.Id = types.StringValue(strconv.FormatInt(*actual.Id, 10))
 	newState.Username = types.StringValue(actual.Username)
+	newState.Name = types.StringValue(stringValue(actual.Name))
+	newState.Email = types.StringValue(stringValue(actual.Email))
+	newState.LastLogin = types.StringValue(teamcityTime(actual.LastLogin))
+
+	serverProps := map[string]string{}
+	if actual.Properties != nil {
+		for _, p := range actual.Properties.Property {
+			serverProps[p.Name] = p.Value
+		}
+	}
+	if github, ok := serverProps[githubUsernameProperty]; ok {
+		newState.Github = types.StringValue(github)
+	}
 
-	for _, p := range actual.Properties.Property {
-		if p.Name == "plugin:auth:GitHubApp-oauth:userName" {
-			newState.Github = types.StringValue(p.Value)
-			break
+	newState.Properties = types.MapNull(types.StringType)
+	if !prior.Properties.IsNull() && !prior.Properties.IsUnknown() {
+		managed := map[string]string{}
+		for name := range prior.Properties.Elements() {
+			if value, ok := serverProps[name]; ok {
+				managed[name] = value
+			}
+		}
+		props, d := types.MapValueFrom(ctx, types.StringType, managed)
+		diags.Append(d...)
+		newState.Properties = props
+	}
+
+	newState.Groups = types.SetNull(types.StringType)
+	if !prior.Groups.IsNull() {
+		keys := []string{}
+		if actual.Groups != nil {
+			for _, g := range actual.Groups.Group {
+				keys = append(keys, g.Key)
+			}
 		}
+		groups, d := types.SetValueFrom(ctx, types.StringType, keys)
+		diags.Append(d...)
+		newState.Groups = groups
 	}
 
 	if actual.Roles != nil && len(actual.Roles.RoleAssignment) > 0 {
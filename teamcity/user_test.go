@@ -0,0 +1,90 @@
+package teamcity
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserResource_profile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                    resource "teamcity_project" "test" {
+                        name = "User Profile Project"
+                    }
+
+                    resource "teamcity_group" "test" {
+                        name = "user_profile_group"
+                    }
+
+                    resource "teamcity_user" "test" {
+                        username = "profile_user"
+                        name     = "Profile User"
+                        email    = "profile.user@example.com"
+                        groups   = [teamcity_group.test.id]
+
+                        properties = {
+                            "plugin:vcs:jetbrains.git:anyVcsRoot" = "puser"
+                        }
+
+                        roles = [
+                            {
+                                id      = "PROJECT_DEVELOPER"
+                                project = teamcity_project.test.id
+                            }
+                        ]
+                    }
+                `,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_user.test", "name", "Profile User"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "email", "profile.user@example.com"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "properties.plugin:vcs:jetbrains.git:anyVcsRoot", "puser"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "roles.0.id", "PROJECT_DEVELOPER"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "last_login", ""),
+				),
+			},
+			{
+				Config: providerConfig + `
+                    resource "teamcity_project" "test" {
+                        name = "User Profile Project"
+                    }
+
+                    resource "teamcity_group" "test" {
+                        name = "user_profile_group"
+                    }
+
+                    resource "teamcity_user" "test" {
+                        username = "profile_user"
+                        name     = "Renamed User"
+                        email    = "profile.user@example.com"
+                        groups   = []
+                        properties = {}
+                    }
+                `,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_user.test", "name", "Renamed User"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "groups.#", "0"),
+					resource.TestCheckResourceAttr("teamcity_user.test", "properties.%", "0"),
+					resource.TestCheckNoResourceAttr("teamcity_user.test", "roles"),
+				),
+			},
+		},
+	})
+}
+
+func TestTeamcityTime(t *testing.T) {
+	if got := teamcityTime("20240115T101112+0100"); got != "2024-01-15T10:11:12+01:00" {
+		t.Fatalf("unexpected time: %s", got)
+	}
+	if got := teamcityTime(""); got != "" {
+		t.Fatalf("expected empty time, got %s", got)
+	}
+	if got := teamcityTime("yesterday"); got != "yesterday" {
+		t.Fatalf("expected the raw value, got %s", got)
+	}
+}
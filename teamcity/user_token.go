@@ -0,0 +1,299 @@
+package teamcity
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &userTokenResource{}
+	_ resource.ResourceWithConfigure      = &userTokenResource{}
+	_ resource.ResourceWithImportState    = &userTokenResource{}
+	_ resource.ResourceWithValidateConfig = &userTokenResource{}
+)
+
+func NewUserTokenResource() resource.Resource {
+	return &userTokenResource{}
+}
+
+type userTokenResource struct {
+	client *client.Client
+}
+
+type userTokenResourceModel struct {
+	Id           types.String                `tfsdk:"id"`
+	UserId       types.String                `tfsdk:"user_id"`
+	Name         types.String                `tfsdk:"name"`
+	Expiration   types.String                `tfsdk:"expiration"`
+	Restrictions []userTokenRestrictionModel `tfsdk:"permission_restrictions"`
+	Value        types.String                `tfsdk:"value"`
+	CreationTime types.String                `tfsdk:"creation_time"`
+}
+
+type userTokenRestrictionModel struct {
+	ProjectId  types.String `tfsdk:"project_id"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+func (r *userTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *userTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an access token of a user. Tokens can't be modified, any change replaces the token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource identifier in the format `user_id/name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the user owning the token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the token, unique per user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Optional:    true,
+				Description: "Expiration time of the token in RFC 3339 format. The token never expires when omitted. Changing it rotates the token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_restrictions": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "Restricts the token to the listed permissions. The token has all permissions of the user when omitted.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "Project the permission is granted in, the permission is global when omitted.",
+						},
+						"permission": schema.StringAttribute{
+							Required:    true,
+							Description: "Permission ID, e.g. `view_project` or `run_build`.",
+						},
+					},
+				},
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret value of the token. Only available when the token is created, it is empty after import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation time of the token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *userTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expiration types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expiration"), &expiration)...)
+	if expiration.IsNull() || expiration.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, expiration.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiration"),
+			"Invalid expiration",
+			"Expiration must be in RFC 3339 format, e.g. 2030-01-01T00:00:00Z: "+err.Error(),
+		)
+	}
+}
+
+func (r *userTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := models.TokenJson{
+		Name: plan.Name.ValueString(),
+	}
+	if !plan.Expiration.IsNull() {
+		expiration, err := time.Parse(time.RFC3339, plan.Expiration.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid expiration", err.Error())
+			return
+		}
+		token.ExpirationTime = expiration.Format(teamcityTimeFormat)
+	}
+	if plan.Restrictions != nil {
+		token.PermissionRestrictions = &models.PermissionRestrictionsJson{
+			PermissionRestriction: []models.PermissionRestrictionJson{},
+		}
+		for _, restriction := range plan.Restrictions {
+			item := models.PermissionRestrictionJson{
+				IsGlobalScope: restriction.ProjectId.IsNull(),
+				Permission:    models.PermissionJson{Id: restriction.Permission.ValueString()},
+			}
+			if !restriction.ProjectId.IsNull() {
+				item.Project = &models.ProjectRefJson{Id: restriction.ProjectId.ValueString()}
+			}
+			token.PermissionRestrictions.PermissionRestriction = append(token.PermissionRestrictions.PermissionRestriction, item)
+		}
+	}
+
+	actual, err := r.client.NewUserToken(plan.UserId.ValueString(), token)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user token",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(plan.UserId.ValueString() + "/" + plan.Name.ValueString())
+	plan.Value = types.StringValue(actual.Value)
+	plan.CreationTime = types.StringValue(teamcityTime(actual.CreationTime))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actual, err := r.client.GetUserToken(state.UserId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user token",
+			err.Error(),
+		)
+		return
+	}
+
+	if actual == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if actual.ExpirationTime != "" {
+		expiration, err := time.Parse(teamcityTimeFormat, actual.ExpirationTime)
+		if err == nil && expiration.Before(time.Now()) {
+			resp.Diagnostics.AddWarning(
+				"User token expired",
+				"The token '"+state.Name.ValueString()+"' expired at "+expiration.Format(time.RFC3339)+" and will be recreated.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		// Keep the configured value if it is the same instant in another time zone
+		prior, err := time.Parse(time.RFC3339, state.Expiration.ValueString())
+		if err != nil || !prior.Equal(expiration) {
+			state.Expiration = types.StringValue(teamcityTime(actual.ExpirationTime))
+		}
+	} else {
+		state.Expiration = types.StringNull()
+	}
+
+	state.Restrictions = nil
+	if actual.PermissionRestrictions != nil && len(actual.PermissionRestrictions.PermissionRestriction) > 0 {
+		for _, restriction := range actual.PermissionRestrictions.PermissionRestriction {
+			item := userTokenRestrictionModel{
+				ProjectId:  types.StringNull(),
+				Permission: types.StringValue(restriction.Permission.Id),
+			}
+			if !restriction.IsGlobalScope && restriction.Project != nil {
+				item.ProjectId = types.StringValue(restriction.Project.Id)
+			}
+			state.Restrictions = append(state.Restrictions, item)
+		}
+	}
+
+	state.Id = types.StringValue(state.UserId.ValueString() + "/" + state.Name.ValueString())
+	state.CreationTime = types.StringValue(teamcityTime(actual.CreationTime))
+	if state.Value.IsNull() {
+		state.Value = types.StringValue("")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userTokenResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update not supported",
+		"User tokens can't be modified, all changes require replacement.",
+	)
+}
+
+func (r *userTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUserToken(state.UserId.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting user token",
+			err.Error(),
+		)
+	}
+}
+
+func (r *userTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userId, name, ok := strings.Cut(req.ID, "/")
+	if !ok || userId == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format: user_id/name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,65 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccUserToken_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_user" "bot" {
+  username = "test_token_bot"
+}
+
+resource "teamcity_user_token" "test" {
+  user_id    = teamcity_user.bot.id
+  name       = "pipeline"
+  expiration = "2099-01-01T00:00:00Z"
+
+  permission_restrictions = [
+    {
+      project_id = "_Root"
+      permission = "view_project"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_user_token.test", "name", "pipeline"),
+					resource.TestCheckResourceAttr("teamcity_user_token.test", "expiration", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("teamcity_user_token.test", "permission_restrictions.#", "1"),
+					resource.TestCheckResourceAttrSet("teamcity_user_token.test", "value"),
+					resource.TestCheckResourceAttrSet("teamcity_user_token.test", "creation_time"),
+				),
+			},
+			{
+				ResourceName:            "teamcity_user_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			{
+				Config: providerConfig + `
+resource "teamcity_user" "bot" {
+  username = "test_token_bot"
+}
+
+resource "teamcity_user_token" "test" {
+  user_id    = teamcity_user.bot.id
+  name       = "pipeline"
+  expiration = "2099-06-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_user_token.test", "expiration", "2099-06-01T00:00:00Z"),
+					resource.TestCheckNoResourceAttr("teamcity_user_token.test", "permission_restrictions"),
+					resource.TestCheckResourceAttrSet("teamcity_user_token.test", "value"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,289 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-teamcity/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &userVcsUsernameResource{}
+	_ resource.ResourceWithConfigure   = &userVcsUsernameResource{}
+	_ resource.ResourceWithImportState = &userVcsUsernameResource{}
+)
+
+const (
+	anyVcs     = "anyVcs"
+	anyVcsRoot = "anyVcsRoot"
+)
+
+func NewUserVcsUsernameResource() resource.Resource {
+	return &userVcsUsernameResource{}
+}
+
+type userVcsUsernameResource struct {
+	client *client.Client
+}
+
+type userVcsUsernameResourceModel struct {
+	Id        types.String   `tfsdk:"id"`
+	UserId    types.String   `tfsdk:"user_id"`
+	VcsType   types.String   `tfsdk:"vcs_type"`
+	VcsRootId types.String   `tfsdk:"vcs_root_id"`
+	Usernames []types.String `tfsdk:"usernames"`
+}
+
+func (r *userVcsUsernameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_vcs_username"
+}
+
+func (r *userVcsUsernameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Maps commit authors to a user. The usernames apply to all VCS roots of a VCS type, or to a single VCS root.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource identifier in the format `user_id/vcs_type/vcs_root_id`, `anyVcsRoot` standing for all roots.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vcs_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "VCS type, e.g. `jetbrains.git` or `perforce`. Defaults to the type of `vcs_root_id` if set, otherwise to `anyVcs` matching all VCS types.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vcs_root_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the VCS root the usernames apply to. The usernames apply to all VCS roots when omitted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"usernames": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Usernames of the user in the VCS, e.g. commit author names or emails.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *userVcsUsernameResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *userVcsUsernameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userVcsUsernameResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := r.propertyName(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setUsernames(plan, name, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userVcsUsernameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userVcsUsernameResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.VcsRootId.IsNull() {
+		root, err := r.client.GetVcsRootInternalId(state.VcsRootId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading VCS root", err.Error())
+			return
+		}
+		if root == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	name := r.propertyName(&state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(state.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	value, found := "", false
+	if user.Properties != nil {
+		for _, p := range user.Properties.Property {
+			if p.Name == name {
+				value, found = p.Value, true
+				break
+			}
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Usernames = []types.String{}
+	for _, username := range strings.Split(value, "\n") {
+		if username = strings.TrimSpace(username); username != "" {
+			state.Usernames = append(state.Usernames, types.StringValue(username))
+		}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userVcsUsernameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userVcsUsernameResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := r.propertyName(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setUsernames(plan, name, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userVcsUsernameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userVcsUsernameResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := r.propertyName(&state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.SetField("users", state.UserId.ValueString(), "properties/"+name, nil); err != nil {
+		resp.Diagnostics.AddError("Error deleting VCS usernames", err.Error())
+	}
+}
+
+func (r *userVcsUsernameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in the format: user_id/vcs_type/vcs_root_id, use anyVcsRoot as vcs_root_id for all VCS roots",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vcs_type"), parts[1])...)
+	if parts[2] != anyVcsRoot {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vcs_root_id"), parts[2])...)
+	}
+}
+
+// propertyName returns the user property holding the usernames,
+// plugin:vcs:<vcs type>:<internal VCS root ID or anyVcsRoot>. It fills
+// in the computed VCS type and ID of the model.
+func (r *userVcsUsernameResource) propertyName(model *userVcsUsernameResourceModel, diags *diag.Diagnostics) string {
+	vcsType, scope, rootId := model.VcsType.ValueString(), anyVcsRoot, anyVcsRoot
+
+	if !model.VcsRootId.IsNull() {
+		root, err := r.client.GetVcsRootInternalId(model.VcsRootId.ValueString())
+		if err != nil {
+			diags.AddError("Error reading VCS root", err.Error())
+			return ""
+		}
+		if root == nil || root.InternalId == nil {
+			diags.AddError("VCS root not found", "The VCS root '"+model.VcsRootId.ValueString()+"' was not found")
+			return ""
+		}
+		if vcsType == "" {
+			vcsType = root.VcsName
+		} else if vcsType != root.VcsName {
+			diags.AddAttributeError(
+				path.Root("vcs_type"),
+				"VCS type mismatch",
+				fmt.Sprintf("The VCS root '%s' is of type '%s', not '%s'", model.VcsRootId.ValueString(), root.VcsName, vcsType),
+			)
+			return ""
+		}
+		scope = fmt.Sprint(*root.InternalId)
+		rootId = model.VcsRootId.ValueString()
+	} else if vcsType == "" {
+		vcsType = anyVcs
+	}
+
+	model.VcsType = types.StringValue(vcsType)
+	model.Id = types.StringValue(model.UserId.ValueString() + "/" + vcsType + "/" + rootId)
+	return "plugin:vcs:" + vcsType + ":" + scope
+}
+
+func (r *userVcsUsernameResource) setUsernames(model userVcsUsernameResourceModel, name string, diags *diag.Diagnostics) {
+	var usernames []string
+	for _, username := range model.Usernames {
+		usernames = append(usernames, username.ValueString())
+	}
+	value := strings.Join(usernames, "\n")
+
+	if _, err := r.client.SetField("users", model.UserId.ValueString(), "properties/"+name, &value); err != nil {
+		diags.AddError("Error setting VCS usernames", err.Error())
+	}
+}
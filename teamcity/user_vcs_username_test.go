@@ -0,0 +1,56 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccUserVcsUsername_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "teamcity_user" "test" {
+  username = "test_vcs_username_user"
+}
+
+resource "teamcity_vcsroot" "test" {
+  name       = "vcs_username_root"
+  project_id = "_Root"
+  git = {
+    url    = "git@github.com:mkuzmin/test.git"
+    branch = "master"
+  }
+}
+
+resource "teamcity_user_vcs_username" "any" {
+  user_id   = teamcity_user.test.id
+  usernames = ["jdoe", "john.doe@example.com"]
+}
+
+resource "teamcity_user_vcs_username" "root" {
+  user_id     = teamcity_user.test.id
+  vcs_root_id = teamcity_vcsroot.test.id
+  usernames   = ["john-doe"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("teamcity_user_vcs_username.any", "vcs_type", "anyVcs"),
+					resource.TestCheckResourceAttr("teamcity_user_vcs_username.any", "usernames.#", "2"),
+					resource.TestCheckResourceAttr("teamcity_user_vcs_username.root", "vcs_type", "jetbrains.git"),
+					resource.TestCheckResourceAttr("teamcity_user_vcs_username.root", "usernames.0", "john-doe"),
+				),
+			},
+			{
+				ResourceName:      "teamcity_user_vcs_username.root",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["teamcity_user_vcs_username.root"].Primary.ID, nil
+				},
+			},
+		},
+	})
+}
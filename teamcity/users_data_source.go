@@ -0,0 +1,240 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-teamcity/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+type usersDataSource struct {
+	client *client.Client
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+type usersDataSourceModel struct {
+	Group           types.String         `tfsdk:"group"`
+	Role            types.String         `tfsdk:"role"`
+	RoleProject     types.String         `tfsdk:"role_project"`
+	PropertyName    types.String         `tfsdk:"property_name"`
+	PropertyValue   types.String         `tfsdk:"property_value"`
+	LastLoginBefore types.String         `tfsdk:"last_login_before"`
+	Users           []usersDataUserModel `tfsdk:"users"`
+}
+
+type usersDataUserModel struct {
+	Id        types.String     `tfsdk:"id"`
+	Username  types.String     `tfsdk:"username"`
+	Name      types.String     `tfsdk:"name"`
+	Email     types.String     `tfsdk:"email"`
+	LastLogin types.String     `tfsdk:"last_login"`
+	Groups    []types.String   `tfsdk:"groups"`
+	Roles     []roleAssignment `tfsdk:"roles"`
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to find TeamCity users. All filters are optional and combined, all users are returned when none is set.",
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Optional:    true,
+				Description: "Key of the group the users are members of.",
+			},
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of a role assigned to the users.",
+			},
+			"role_project": schema.StringAttribute{
+				Optional:    true,
+				Description: "Project ID the `role` is assigned in. The role is matched in any scope when not set.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("role")),
+				},
+			},
+			"property_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a user property the users have.",
+			},
+			"property_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "Value of the `property_name` property.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("property_name")),
+				},
+			},
+			"last_login_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Time in RFC 3339 format, only users who last logged in before it are returned. Users who never logged in are not matched.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_login": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time of the last login in RFC 3339 format, empty if the user never logged in.",
+						},
+						"groups": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Keys of the groups the user is a direct member of.",
+						},
+						"roles": schema.SetNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed: true,
+									},
+									"global": schema.BoolAttribute{
+										Computed: true,
+									},
+									"project": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config usersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locator, err := usersLocator(config)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("last_login_before"),
+			"Invalid time",
+			err.Error(),
+		)
+		return
+	}
+
+	users, err := d.client.GetUsers(locator)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading users",
+			err.Error(),
+		)
+		return
+	}
+
+	config.Users = []usersDataUserModel{}
+	for _, u := range users {
+		user := usersDataUserModel{
+			Id:        types.StringValue(strconv.FormatInt(*u.Id, 10)),
+			Username:  types.StringValue(u.Username),
+			Name:      types.StringValue(stringValue(u.Name)),
+			Email:     types.StringValue(stringValue(u.Email)),
+			LastLogin: types.StringValue(teamcityTime(u.LastLogin)),
+			Groups:    []types.String{},
+			Roles:     []roleAssignment{},
+		}
+		if u.Groups != nil {
+			for _, g := range u.Groups.Group {
+				user.Groups = append(user.Groups, types.StringValue(g.Key))
+			}
+		}
+		if u.Roles != nil {
+			for _, role := range u.Roles.RoleAssignment {
+				assignment := roleAssignment{
+					Id: types.StringValue(role.Id),
+				}
+				if role.Scope == "g" {
+					assignment.Global = types.BoolValue(true)
+				} else {
+					assignment.Global = types.BoolValue(false)
+					assignment.Project = types.StringValue(strings.TrimPrefix(role.Scope, "p:"))
+				}
+				user.Roles = append(user.Roles, assignment)
+			}
+		}
+		config.Users = append(config.Users, user)
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// usersLocator builds the TeamCity user locator from the filters
+func usersLocator(config usersDataSourceModel) (string, error) {
+	var dimensions []string
+
+	if !config.Group.IsNull() {
+		dimensions = append(dimensions, fmt.Sprintf("group:(key:%s)", config.Group.ValueString()))
+	}
+	if !config.Role.IsNull() {
+		role := "role:" + config.Role.ValueString()
+		if !config.RoleProject.IsNull() {
+			role += ",scope:p:" + config.RoleProject.ValueString()
+		}
+		dimensions = append(dimensions, fmt.Sprintf("role:(%s)", role))
+	}
+	if !config.PropertyName.IsNull() {
+		property := "name:" + config.PropertyName.ValueString()
+		if !config.PropertyValue.IsNull() {
+			property += ",value:" + config.PropertyValue.ValueString()
+		}
+		dimensions = append(dimensions, fmt.Sprintf("property:(%s)", property))
+	}
+	if !config.LastLoginBefore.IsNull() {
+		before, err := time.Parse(time.RFC3339, config.LastLoginBefore.ValueString())
+		if err != nil {
+			return "", err
+		}
+		dimensions = append(dimensions, fmt.Sprintf("lastLogin:(date:%s,condition:before)", before.Format("20060102T150405-0700")))
+	}
+
+	return strings.Join(dimensions, ","), nil
+}
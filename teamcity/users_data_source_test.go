@@ -0,0 +1,77 @@
+package teamcity
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                    resource "teamcity_group" "test" {
+                        name = "users_ds_group"
+                    }
+
+                    resource "teamcity_user" "member" {
+                        username = "users_ds_member"
+                        email    = "users_ds_member@example.com"
+                        groups   = [teamcity_group.test.id]
+                    }
+
+                    resource "teamcity_user" "other" {
+                        username = "users_ds_other"
+                    }
+
+                    data "teamcity_users" "group" {
+                        group = teamcity_group.test.id
+
+                        depends_on = [teamcity_user.member, teamcity_user.other]
+                    }
+
+                    data "teamcity_groups" "all" {
+                        depends_on = [teamcity_user.member]
+                    }
+                `,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.teamcity_users.group", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.teamcity_users.group", "users.0.username", "users_ds_member"),
+					resource.TestCheckResourceAttr("data.teamcity_users.group", "users.0.email", "users_ds_member@example.com"),
+					resource.TestCheckResourceAttrPair("data.teamcity_users.group", "users.0.id", "teamcity_user.member", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.teamcity_groups.all", "groups.*", map[string]string{
+						"name":      "users_ds_group",
+						"members.0": "users_ds_member",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUsersLocator(t *testing.T) {
+	locator, err := usersLocator(usersDataSourceModel{
+		Group:           types.StringValue("DEVS"),
+		Role:            types.StringValue("PROJECT_DEVELOPER"),
+		RoleProject:     types.StringValue("MyProject"),
+		PropertyName:    types.StringNull(),
+		PropertyValue:   types.StringNull(),
+		LastLoginBefore: types.StringValue("2024-01-15T10:00:00Z"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "group:(key:DEVS),role:(role:PROJECT_DEVELOPER,scope:p:MyProject),lastLogin:(date:20240115T100000+0000,condition:before)"
+	if locator != expected {
+		t.Fatalf("unexpected locator: %s, expected: %s", locator, expected)
+	}
+
+	_, err = usersLocator(usersDataSourceModel{LastLoginBefore: types.StringValue("90 days ago")})
+	if err == nil {
+		t.Fatal("expected an error for an invalid time")
+	}
+}
@@ -41,27 +41,31 @@ type vcsRootResourceModel struct {
 }
 
 type GitPropertiesModel struct {
-	Url              types.String `tfsdk:"url" teamcity:"url"`
-	PushUrl          types.String `tfsdk:"push_url"`
-	Branch           types.String `tfsdk:"branch" teamcity:"branch"`
-	BranchSpec       types.String `tfsdk:"branch_spec"`
-	TagsAsBranches   types.Bool   `tfsdk:"tags_as_branches"`
-	UsernameStyle    types.String `tfsdk:"username_style"`
-	Submodules       types.String `tfsdk:"submodules"`
-	UsernameForTags  types.String `tfsdk:"username_for_tags"`
-	AuthMethod       types.String `tfsdk:"auth_method"`
-	Username         types.String `tfsdk:"username"`
-	Password         types.String `tfsdk:"password"`
-	UploadedKey      types.String `tfsdk:"uploaded_key"`
-	PrivateKeyPath   types.String `tfsdk:"private_key_path"`
-	Passphrase       types.String `tfsdk:"passphrase"`
-	IgnoreKnownHosts types.Bool   `tfsdk:"ignore_known_hosts"`
-	ConvertCrlf      types.Bool   `tfsdk:"convert_crlf"`
-	PathToGit        types.String `tfsdk:"path_to_git"`
-	CheckoutPolicy   types.String `tfsdk:"checkout_policy"`
-	CleanPolicy      types.String `tfsdk:"clean_policy"`
-	CleanFilesPolicy types.String `tfsdk:"clean_files_policy"`
-	TokenId          types.String `tfsdk:"token_id"`
+	Url               types.String `tfsdk:"url" teamcity:"url"`
+	PushUrl           types.String `tfsdk:"push_url"`
+	Branch            types.String `tfsdk:"branch" teamcity:"branch"`
+	BranchSpec        types.String `tfsdk:"branch_spec"`
+	TagsAsBranches    types.Bool   `tfsdk:"tags_as_branches"`
+	UsernameStyle     types.String `tfsdk:"username_style"`
+	Submodules        types.String `tfsdk:"submodules"`
+	UsernameForTags   types.String `tfsdk:"username_for_tags"`
+	AuthMethod        types.String `tfsdk:"auth_method"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordVersion   types.Int64  `tfsdk:"password_wo_version"`
+	UploadedKey       types.String `tfsdk:"uploaded_key"`
+	PrivateKeyPath    types.String `tfsdk:"private_key_path"`
+	Passphrase        types.String `tfsdk:"passphrase"`
+	PassphraseWo      types.String `tfsdk:"passphrase_wo"`
+	PassphraseVersion types.Int64  `tfsdk:"passphrase_wo_version"`
+	IgnoreKnownHosts  types.Bool   `tfsdk:"ignore_known_hosts"`
+	ConvertCrlf       types.Bool   `tfsdk:"convert_crlf"`
+	PathToGit         types.String `tfsdk:"path_to_git"`
+	CheckoutPolicy    types.String `tfsdk:"checkout_policy"`
+	CleanPolicy       types.String `tfsdk:"clean_policy"`
+	CleanFilesPolicy  types.String `tfsdk:"clean_files_policy"`
+	TokenId           types.String `tfsdk:"token_id"`
 }
 
 func (r *vcsRootResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -148,6 +152,8 @@ func (r *vcsRootResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						Optional:  true,
 						Sensitive: true,
 					},
+					"password_wo":         writeOnlyAttribute("password", false),
+					"password_wo_version": writeOnlyVersionAttribute("password"),
 					"uploaded_key": schema.StringAttribute{
 						Optional: true,
 					},
@@ -158,6 +164,8 @@ func (r *vcsRootResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 						Optional:  true,
 						Sensitive: true,
 					},
+					"passphrase_wo":         writeOnlyAttribute("passphrase", false),
+					"passphrase_wo_version": writeOnlyVersionAttribute("passphrase"),
 					"ignore_known_hosts": schema.BoolAttribute{
 						Optional: true,
 						Computed: true,
@@ -217,6 +225,12 @@ func (r *vcsRootResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	passwordWo := writeOnlyValue(ctx, req.Config, path.Root("git").AtName("password_wo"), &resp.Diagnostics)
+	passphraseWo := writeOnlyValue(ctx, req.Config, path.Root("git").AtName("passphrase_wo"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var id *string
 	if plan.Id.IsUnknown() {
 		id = nil
@@ -272,8 +286,8 @@ func (r *vcsRootResource) Create(ctx context.Context, req resource.CreateRequest
 		props = append(props, models.Property{Name: "username", Value: plan.Git.Username.ValueString()})
 	}
 
-	if plan.Git.Password.IsNull() != true {
-		props = append(props, models.Property{Name: "secure:password", Value: plan.Git.Password.ValueString()})
+	if password := secretValue(plan.Git.Password, passwordWo); password.IsNull() != true {
+		props = append(props, models.Property{Name: "secure:password", Value: password.ValueString()})
 	}
 
 	if plan.Git.UploadedKey.IsNull() != true {
@@ -284,8 +298,8 @@ func (r *vcsRootResource) Create(ctx context.Context, req resource.CreateRequest
 		props = append(props, models.Property{Name: "privateKeyPath", Value: plan.Git.PrivateKeyPath.ValueString()})
 	}
 
-	if plan.Git.Passphrase.IsNull() != true {
-		props = append(props, models.Property{Name: "secure:passphrase", Value: plan.Git.Passphrase.ValueString()})
+	if passphrase := secretValue(plan.Git.Passphrase, passphraseWo); passphrase.IsNull() != true {
+		props = append(props, models.Property{Name: "secure:passphrase", Value: passphrase.ValueString()})
 	}
 
 	if plan.Git.IgnoreKnownHosts.IsNull() != true {
@@ -346,6 +360,8 @@ func (r *vcsRootResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 	newState.Git.Password = plan.Git.Password
 	newState.Git.Passphrase = plan.Git.Passphrase
+	newState.Git.PasswordVersion = plan.Git.PasswordVersion
+	newState.Git.PassphraseVersion = plan.Git.PassphraseVersion
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -386,6 +402,8 @@ func (r *vcsRootResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if oldState.Git != nil {
 		newState.Git.Password = oldState.Git.Password
 		newState.Git.Passphrase = oldState.Git.Passphrase
+		newState.Git.PasswordVersion = oldState.Git.PasswordVersion
+		newState.Git.PassphraseVersion = oldState.Git.PassphraseVersion
 	}
 
 	diags = resp.State.Set(ctx, newState)
@@ -600,6 +618,14 @@ func (r *vcsRootResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if writeOnlyChanged(plan.Git.PasswordVersion, oldState.Git.PasswordVersion, plan.Git.Password, oldState.Git.Password) {
+		value := writeOnlyValue(ctx, req.Config, path.Root("git").AtName("password_wo"), &resp.Diagnostics)
+		if !r.setWriteOnlyField(resourceId, "properties/secure:password", value, &resp.Diagnostics) {
+			return
+		}
+	}
+	newState.Git.PasswordVersion = plan.Git.PasswordVersion
+
 	if result, ok := r.setFieldString(resourceId, "properties/teamcitySshKey", oldState.Git.UploadedKey, plan.Git.UploadedKey, &resp.Diagnostics); ok {
 		newState.Git.UploadedKey = result
 	} else {
@@ -618,6 +644,14 @@ func (r *vcsRootResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if writeOnlyChanged(plan.Git.PassphraseVersion, oldState.Git.PassphraseVersion, plan.Git.Passphrase, oldState.Git.Passphrase) {
+		value := writeOnlyValue(ctx, req.Config, path.Root("git").AtName("passphrase_wo"), &resp.Diagnostics)
+		if !r.setWriteOnlyField(resourceId, "properties/secure:passphrase", value, &resp.Diagnostics) {
+			return
+		}
+	}
+	newState.Git.PassphraseVersion = plan.Git.PassphraseVersion
+
 	if result, ok := r.setFieldBool(resourceId, "properties/ignoreKnownHosts", oldState.Git.IgnoreKnownHosts, plan.Git.IgnoreKnownHosts, &resp.Diagnostics); ok {
 		newState.Git.IgnoreKnownHosts = result
 	} else {
@@ -702,6 +736,22 @@ func (r *vcsRootResource) setFieldString(id, name string, state, plan types.Stri
 	return types.StringValue(result), true
 }
 
+// setWriteOnlyField sends the value of a write-only attribute, nothing is sent when it is not set.
+func (r *vcsRootResource) setWriteOnlyField(id, name string, value types.String, diag *diag.Diagnostics) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return !diag.HasError()
+	}
+
+	if _, err := r.client.SetField("vcs-roots", id, name, value.ValueStringPointer()); err != nil {
+		diag.AddError(
+			"Error setting VCS root field",
+			err.Error(),
+		)
+		return false
+	}
+	return true
+}
+
 func (r *vcsRootResource) setFieldInt(id, name string, state, plan types.Int64, diag *diag.Diagnostics) (types.Int64, bool) {
 	if plan.Equal(state) {
 		return state, true
@@ -2,27 +2,41 @@ package teamcity
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"terraform-provider-teamcity/client"
 	"terraform-provider-teamcity/models"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &versionedSettingsResource{}
-	_ resource.ResourceWithConfigure   = &versionedSettingsResource{}
-	_ resource.ResourceWithImportState = &versionedSettingsResource{}
+	_ resource.Resource                   = &versionedSettingsResource{}
+	_ resource.ResourceWithConfigure      = &versionedSettingsResource{}
+	_ resource.ResourceWithImportState    = &versionedSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &versionedSettingsResource{}
 )
 
+const defaultSyncTimeout = 10 * time.Minute
+
+// defaultSettingsPath is the settings directory used by the server when none is set.
+const defaultSettingsPath = ".teamcity"
+
+// syncPollInterval is the delay between two reads of the synchronization status.
+var syncPollInterval = 5 * time.Second
+
 func NewVersionedSettingsResource() resource.Resource {
 	return &versionedSettingsResource{}
 }
@@ -54,6 +68,60 @@ func (r *versionedSettingsResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"show_changes": schema.BoolAttribute{Required: true},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("kotlin"),
+				Description: "Format of the settings in the VCS, `kotlin` or `xml`. Defaults to `kotlin`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"kotlin", "xml"}...),
+				},
+			},
+			"store_secure_values_outside_vcs": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Store passwords and other secure values in TeamCity instead of the VCS, they are referenced by tokens in the settings. Defaults to `true`.",
+			},
+			"import_decision": schema.StringAttribute{
+				Optional:    true,
+				Description: "What to do on the first synchronization when the VCS already contains settings: `importFromVCS` (default) loads them into TeamCity, `overrideInVCS` commits the current settings. Ignored afterwards.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"importFromVCS", "overrideInVCS"}...),
+				},
+			},
+			"settings_path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Path of the settings directory in the VCS root, `.teamcity` when omitted. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the last synchronization status reported by the server, e.g. `info` or `warn`.",
+			},
+			"sync_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Message of the last synchronization status.",
+			},
+			"wait_for_sync": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait on create, on update of `vcsroot_id` or `format` and when `settings` changes to `useFromVCS`, until TeamCity has synchronized the settings. Synchronization errors, e.g. Kotlin DSL compilation errors, fail the apply.",
+			},
+			"sync_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the synchronization, e.g. `5m` or `1h`. Defaults to `10m`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("wait_for_sync")),
+				},
+			},
+			"last_error": schema.StringAttribute{
+				Computed:    true,
+				Description: "Error of the last synchronization, e.g. a Kotlin DSL compilation failure. Empty when the last synchronization succeeded.",
+			},
 		},
 	}
 }
@@ -65,6 +133,22 @@ func (r *versionedSettingsResource) Configure(_ context.Context, req resource.Co
 	r.client = req.ProviderData.(*client.Client)
 }
 
+func (r *versionedSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sync_timeout"), &timeout)...)
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sync_timeout"),
+			"Invalid sync timeout",
+			"The timeout must be a positive duration, e.g. 10m or 1h30m.",
+		)
+	}
+}
+
 func (r *versionedSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan models.VersionedSettingsModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -74,12 +158,15 @@ func (r *versionedSettingsResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	root := plan.VcsRoot.ValueString()
-	format := "kotlin"
+	format := plan.Format.ValueString()
 	editing := plan.AllowUIEditing.ValueBool()
-	secureValuesOutsideVcs := true
+	secureValuesOutsideVcs := plan.SecureOutside.ValueBool()
 	buildSettings := plan.Settings.ValueString()
 	showChanges := plan.ShowChanges.ValueBool()
 	decision := "importFromVCS"
+	if !plan.ImportDecision.IsNull() {
+		decision = plan.ImportDecision.ValueString()
+	}
 	settings := models.VersionedSettingsJson{
 		SynchronizationMode:         "enabled",
 		VcsRootId:                   &root,
@@ -90,8 +177,24 @@ func (r *versionedSettingsResource) Create(ctx context.Context, req resource.Cre
 		ShowSettingsChanges:         &showChanges,
 		ImportDecision:              &decision,
 	}
+	if !plan.SettingsPath.IsUnknown() {
+		settings.SettingsPath = plan.SettingsPath.ValueStringPointer()
+	}
 
 	projectId := plan.ProjectId.ValueString()
+	var prior *models.VersionedSettingsStatusJson
+	if plan.WaitForSync.ValueBool() {
+		status, err := r.client.GetVersionedSettingsStatus(projectId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading versioned settings status",
+				err.Error(),
+			)
+			return
+		}
+		prior = status
+	}
+
 	result, err := r.client.SetVersionedSettings(projectId, settings)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -110,6 +213,24 @@ func (r *versionedSettingsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 	newState.ProjectId = plan.ProjectId
+	newState.ImportDecision = plan.ImportDecision
+	if newState.SettingsPath.IsNull() {
+		newState.SettingsPath = settingsPathOrDefault(plan.SettingsPath)
+	}
+	newState.WaitForSync = plan.WaitForSync
+	newState.SyncTimeout = plan.SyncTimeout
+
+	// The settings are saved even if the synchronization fails, the resource is tainted then
+	if plan.WaitForSync.ValueBool() {
+		r.waitForSync(ctx, projectId, prior, plan.SyncTimeout, &resp.Diagnostics)
+	}
+
+	var statusDiags diag.Diagnostics
+	r.readStatus(projectId, newState, &statusDiags)
+	resp.Diagnostics.Append(statusDiags...)
+	if statusDiags.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, *newState)
 	resp.Diagnostics.Append(diags...)
@@ -139,7 +260,7 @@ func (r *versionedSettingsResource) Read(ctx context.Context, req resource.ReadR
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	if *actual.Format != "kotlin" {
+	if actual.SynchronizationMode != "enabled" {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -153,6 +274,23 @@ func (r *versionedSettingsResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 	newState.ProjectId = oldState.ProjectId
+	newState.ImportDecision = oldState.ImportDecision
+	if newState.SettingsPath.IsNull() {
+		newState.SettingsPath = settingsPathOrDefault(oldState.SettingsPath)
+	}
+	newState.WaitForSync = oldState.WaitForSync
+	newState.SyncTimeout = oldState.SyncTimeout
+
+	r.readStatus(oldState.ProjectId.ValueString(), newState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !newState.LastError.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Versioned settings synchronization failed",
+			"The last synchronization of project '"+oldState.ProjectId.ValueString()+"' failed: "+newState.LastError.ValueString(),
+		)
+	}
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -180,6 +318,20 @@ func (r *versionedSettingsResource) Update(ctx context.Context, req resource.Upd
 	projectId := plan.ProjectId.ValueString()
 	newState.ProjectId = plan.ProjectId
 
+	wait := plan.WaitForSync.ValueBool() && startsImport(oldState, plan)
+	var prior *models.VersionedSettingsStatusJson
+	if wait {
+		status, err := r.client.GetVersionedSettingsStatus(projectId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading versioned settings status",
+				err.Error(),
+			)
+			return
+		}
+		prior = status
+	}
+
 	if result, ok := r.setPropertyString(projectId, "vcsRootId", oldState.VcsRoot, plan.VcsRoot, &resp.Diagnostics); ok {
 		newState.VcsRoot = result
 	} else {
@@ -204,6 +356,34 @@ func (r *versionedSettingsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	if result, ok := r.setPropertyString(projectId, "format", oldState.Format, plan.Format, &resp.Diagnostics); ok {
+		newState.Format = result
+	} else {
+		return
+	}
+
+	if result, ok := r.setPropertyBool(projectId, "storeSecureValuesOutsideVcs", oldState.SecureOutside, plan.SecureOutside, &resp.Diagnostics); ok {
+		newState.SecureOutside = result
+	} else {
+		return
+	}
+
+	newState.ImportDecision = plan.ImportDecision
+	newState.SettingsPath = settingsPathOrDefault(plan.SettingsPath)
+	newState.WaitForSync = plan.WaitForSync
+	newState.SyncTimeout = plan.SyncTimeout
+
+	if wait {
+		r.waitForSync(ctx, projectId, prior, plan.SyncTimeout, &resp.Diagnostics)
+	}
+
+	var statusDiags diag.Diagnostics
+	r.readStatus(projectId, &newState, &statusDiags)
+	resp.Diagnostics.Append(statusDiags...)
+	if statusDiags.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -240,11 +420,129 @@ func (r *versionedSettingsResource) readState(result models.VersionedSettingsJso
 		AllowUIEditing: types.BoolValue(*result.AllowUIEditing),
 		Settings:       types.StringValue(*result.BuildSettingsMode),
 		ShowChanges:    types.BoolValue(*result.ShowSettingsChanges),
+		Format:         types.StringPointerValue(result.Format),
+		SecureOutside:  types.BoolPointerValue(result.StoreSecureValuesOutsideVcs),
+		SettingsPath:   types.StringPointerValue(result.SettingsPath),
 	}
 
 	return &settings, nil
 }
 
+// settingsPathOrDefault is the settings path to keep in the state when the
+// server doesn't report it.
+func settingsPathOrDefault(path types.String) types.String {
+	if path.IsNull() || path.IsUnknown() {
+		return types.StringValue(defaultSettingsPath)
+	}
+	return path
+}
+
+// readStatus fills in the status of the last synchronization, last_error is
+// set when the server reports anything but an info message.
+func (r *versionedSettingsResource) readStatus(projectId string, settings *models.VersionedSettingsModel, diag *diag.Diagnostics) {
+	settings.SyncStatus = types.StringNull()
+	settings.SyncMessage = types.StringNull()
+	settings.LastError = types.StringNull()
+
+	status, err := r.client.GetVersionedSettingsStatus(projectId)
+	if err != nil {
+		diag.AddError(
+			"Error reading versioned settings status",
+			err.Error(),
+		)
+		return
+	}
+	if status == nil || status.Type == "" {
+		return
+	}
+
+	settings.SyncStatus = types.StringValue(status.Type)
+	settings.SyncMessage = types.StringValue(status.Message)
+	if status.Type != "info" {
+		settings.LastError = types.StringValue(status.Message)
+	}
+}
+
+// waitForSync polls the status until a synchronization newer than prior has
+// finished. A failed synchronization is reported as one error per line of the
+// status message, e.g. one per Kotlin DSL compilation error.
+func (r *versionedSettingsResource) waitForSync(ctx context.Context, projectId string, prior *models.VersionedSettingsStatusJson, syncTimeout types.String, diag *diag.Diagnostics) {
+	timeout := defaultSyncTimeout
+	if !syncTimeout.IsNull() {
+		d, err := time.ParseDuration(syncTimeout.ValueString())
+		if err != nil {
+			diag.AddError("Invalid sync timeout", err.Error())
+			return
+		}
+		timeout = d
+	}
+	deadline := time.Now().Add(timeout)
+
+	var status *models.VersionedSettingsStatusJson
+	for {
+		var err error
+		status, err = r.client.GetVersionedSettingsStatus(projectId)
+		if err != nil {
+			diag.AddError(
+				"Error reading versioned settings status",
+				err.Error(),
+			)
+			return
+		}
+		if syncFinished(prior, status) {
+			break
+		}
+
+		if time.Now().Add(syncPollInterval).After(deadline) {
+			message := "no status"
+			if status != nil {
+				message = status.Message
+			}
+			diag.AddError(
+				"Timeout waiting for versioned settings synchronization",
+				fmt.Sprintf("The settings of project '%s' were not synchronized within %s, last status: %s", projectId, timeout, message),
+			)
+			return
+		}
+		select {
+		case <-ctx.Done():
+			diag.AddError("Versioned settings synchronization interrupted", ctx.Err().Error())
+			return
+		case <-time.After(syncPollInterval):
+		}
+	}
+
+	if status.Type == "info" {
+		return
+	}
+	for _, line := range strings.Split(status.Message, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			diag.AddError("Versioned settings synchronization failed", line)
+		}
+	}
+}
+
+// startsImport reports whether the update makes TeamCity load the settings
+// from the VCS.
+func startsImport(state, plan models.VersionedSettingsModel) bool {
+	if !plan.VcsRoot.Equal(state.VcsRoot) || !plan.Format.Equal(state.Format) {
+		return true
+	}
+	return !plan.Settings.Equal(state.Settings) && plan.Settings.ValueString() == "useFromVCS"
+}
+
+// syncFinished reports whether the status describes a completed synchronization
+// newer than prior. TeamCity ends the messages of running operations with "...".
+func syncFinished(prior, status *models.VersionedSettingsStatusJson) bool {
+	if status == nil || status.Type == "" {
+		return false
+	}
+	if prior != nil && status.Timestamp == prior.Timestamp && status.Message == prior.Message {
+		return false
+	}
+	return !strings.HasSuffix(strings.TrimSpace(status.Message), "...")
+}
+
 func (r *versionedSettingsResource) setPropertyString(projectId, name string, state, plan types.String, diag *diag.Diagnostics) (types.String, bool) {
 	if plan.Equal(state) {
 		return state, true
@@ -0,0 +1,138 @@
+package teamcity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"terraform-provider-teamcity/client"
+	"terraform-provider-teamcity/models"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSyncFinished(t *testing.T) {
+	prior := &models.VersionedSettingsStatusJson{Type: "info", Message: "Settings were loaded", Timestamp: "20250101T100000+0000"}
+	cases := []struct {
+		name   string
+		status *models.VersionedSettingsStatusJson
+		want   bool
+	}{
+		{"no status", nil, false},
+		{"unchanged", &models.VersionedSettingsStatusJson{Type: "info", Message: "Settings were loaded", Timestamp: "20250101T100000+0000"}, false},
+		{"running", &models.VersionedSettingsStatusJson{Type: "info", Message: "Running DSL...", Timestamp: "20250101T100500+0000"}, false},
+		{"loaded", &models.VersionedSettingsStatusJson{Type: "info", Message: "Settings were loaded", Timestamp: "20250101T100600+0000"}, true},
+		{"failed", &models.VersionedSettingsStatusJson{Type: "warn", Message: "Compilation error", Timestamp: "20250101T100600+0000"}, true},
+	}
+	for _, c := range cases {
+		if got := syncFinished(prior, c.status); got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestWaitForSync(t *testing.T) {
+	syncPollInterval = 10 * time.Millisecond
+	defer func() { syncPollInterval = 5 * time.Second }()
+
+	serve := func(responses ...string) *httptest.Server {
+		var calls atomic.Int32
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/app/rest/projects/id:Test/versionedSettings/status" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			i := min(int(calls.Add(1))-1, len(responses)-1)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(responses[i]))
+		}))
+	}
+	waitFrom := func(server *httptest.Server, prior *models.VersionedSettingsStatusJson, timeout string) diag.Diagnostics {
+		c := client.NewClient(server.URL, "token", "", "", 0)
+		r := versionedSettingsResource{client: &c}
+		var diags diag.Diagnostics
+		r.waitForSync(context.Background(), "Test", prior, types.StringValue(timeout), &diags)
+		return diags
+	}
+	wait := func(server *httptest.Server, timeout string) diag.Diagnostics {
+		return waitFrom(server, nil, timeout)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		server := serve(
+			`{"type":"info","message":"Running DSL...","timestamp":"20250101T100000+0000"}`,
+			`{"type":"info","message":"Settings were loaded","timestamp":"20250101T100100+0000"}`,
+		)
+		defer server.Close()
+
+		if diags := wait(server, "1s"); diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+	})
+
+	t.Run("DSL errors", func(t *testing.T) {
+		server := serve(`{"type":"warn","message":"settings.kts[12:5]: Unresolved reference\nsettings.kts[20:1]: Expecting '}'","timestamp":"20250101T100100+0000"}`)
+		defer server.Close()
+
+		diags := wait(server, "1s")
+		if diags.ErrorsCount() != 2 {
+			t.Fatalf("expected one error per line, got: %v", diags)
+		}
+		if diags.Errors()[0].Detail() != "settings.kts[12:5]: Unresolved reference" {
+			t.Fatalf("unexpected error: %s", diags.Errors()[0].Detail())
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		server := serve(`{"type":"info","message":"Running DSL...","timestamp":"20250101T100000+0000"}`)
+		defer server.Close()
+
+		diags := wait(server, "50ms")
+		if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Timeout waiting for versioned settings synchronization" {
+			t.Fatalf("expected a timeout, got: %v", diags)
+		}
+	})
+
+	t.Run("no new status", func(t *testing.T) {
+		server := serve(`{"type":"info","message":"Settings were loaded","timestamp":"20250101T100000+0000"}`)
+		defer server.Close()
+
+		// A slow import has not reported a status yet, the wait goes on until the timeout
+		prior := &models.VersionedSettingsStatusJson{Type: "info", Message: "Settings were loaded", Timestamp: "20250101T100000+0000"}
+		diags := waitFrom(server, prior, "50ms")
+		if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Timeout waiting for versioned settings synchronization" {
+			t.Fatalf("expected a timeout, got: %v", diags)
+		}
+	})
+}
+
+func TestStartsImport(t *testing.T) {
+	state := models.VersionedSettingsModel{
+		VcsRoot:        types.StringValue("Root"),
+		Format:         types.StringValue("kotlin"),
+		Settings:       types.StringValue("alwaysUseCurrent"),
+		AllowUIEditing: types.BoolValue(true),
+	}
+	cases := []struct {
+		name   string
+		update func(*models.VersionedSettingsModel)
+		want   bool
+	}{
+		{"unchanged", func(*models.VersionedSettingsModel) {}, false},
+		{"vcs root", func(m *models.VersionedSettingsModel) { m.VcsRoot = types.StringValue("Other") }, true},
+		{"format", func(m *models.VersionedSettingsModel) { m.Format = types.StringValue("xml") }, true},
+		{"use from VCS", func(m *models.VersionedSettingsModel) { m.Settings = types.StringValue("useFromVCS") }, true},
+		{"use current by default", func(m *models.VersionedSettingsModel) { m.Settings = types.StringValue("useCurrentByDefault") }, false},
+		{"UI editing", func(m *models.VersionedSettingsModel) { m.AllowUIEditing = types.BoolValue(false) }, false},
+	}
+	for _, c := range cases {
+		plan := state
+		c.update(&plan)
+		if got := startsImport(state, plan); got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package teamcity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// A secret attribute <name> has a write-only sibling <name>_wo (Terraform 1.11+),
+// whose value is only available in the configuration and never stored in the plan
+// or the state. As Terraform can't detect changes of the write-only value, it is
+// only sent to the server on create and when <name>_wo_version changes.
+
+// writeOnlyAttribute returns the write-only sibling of the secret attribute. When
+// the secret is required, exactly one of the secret and its sibling must be set.
+func writeOnlyAttribute(secret string, required bool) schema.StringAttribute {
+	sibling := path.MatchRelative().AtParent().AtName(secret)
+	v := stringvalidator.ConflictsWith(sibling)
+	if required {
+		v = stringvalidator.ExactlyOneOf(sibling)
+	}
+
+	return schema.StringAttribute{
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Description: fmt.Sprintf("Write-only alternative to `%s`, never stored in the state. Requires Terraform 1.11 or later. Change `%s_wo_version` to send a new value.", secret, secret),
+		Validators:  []validator.String{v},
+	}
+}
+
+// writeOnlyVersionAttribute returns the attribute triggering the update of the write-only secret.
+func writeOnlyVersionAttribute(secret string, modifiers ...planmodifier.Int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Version of `%s_wo`, change it to send a new value.", secret),
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(secret + "_wo")),
+		},
+		PlanModifiers: modifiers,
+	}
+}
+
+// writeOnlyValue reads the write-only attribute from the configuration, it is always null in the plan.
+func writeOnlyValue(ctx context.Context, config tfsdk.Config, p path.Path, diags *diag.Diagnostics) types.String {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, p, &value)...)
+	return value
+}
+
+// secretValue returns the write-only value if it is set, the regular one otherwise.
+func secretValue(value, writeOnly types.String) types.String {
+	if !writeOnly.IsNull() {
+		return writeOnly
+	}
+	return value
+}
+
+// writeOnlyChanged reports whether the write-only secret must be sent on update:
+// its version changed, or it replaces the value of the regular attribute.
+func writeOnlyChanged(planVersion, stateVersion types.Int64, planValue, stateValue types.String) bool {
+	return !planVersion.Equal(stateVersion) || (planValue.IsNull() && !stateValue.IsNull())
+}
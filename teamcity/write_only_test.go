@@ -0,0 +1,35 @@
+package teamcity
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestWriteOnlyChanged(t *testing.T) {
+	cases := []struct {
+		name                      string
+		planVersion, stateVersion types.Int64
+		planValue, stateValue     types.String
+		want                      bool
+	}{
+		{"unchanged", types.Int64Value(1), types.Int64Value(1), types.StringNull(), types.StringNull(), false},
+		{"version bumped", types.Int64Value(2), types.Int64Value(1), types.StringNull(), types.StringNull(), true},
+		{"version added", types.Int64Value(1), types.Int64Null(), types.StringNull(), types.StringNull(), true},
+		{"replaces value", types.Int64Null(), types.Int64Null(), types.StringNull(), types.StringValue("secret"), true},
+		{"regular value", types.Int64Null(), types.Int64Null(), types.StringValue("new"), types.StringValue("old"), false},
+	}
+	for _, c := range cases {
+		if got := writeOnlyChanged(c.planVersion, c.stateVersion, c.planValue, c.stateValue); got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestSecretValue(t *testing.T) {
+	if got := secretValue(types.StringValue("plain"), types.StringNull()); got.ValueString() != "plain" {
+		t.Errorf("expected the regular value, got %q", got.ValueString())
+	}
+	if got := secretValue(types.StringNull(), types.StringValue("wo")); got.ValueString() != "wo" {
+		t.Errorf("expected the write-only value, got %q", got.ValueString())
+	}
+}